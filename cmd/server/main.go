@@ -12,6 +12,8 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -96,23 +98,173 @@ func run(env, address string) (<-chan error, error) {
 		})
 	}
 
+	workers, pollInterval, err := newJobConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newJobConfig %w", err)
+	}
+
+	crawlWorkers, crawlPollInterval, err := newCrawlConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newCrawlConfig %w", err)
+	}
+
+	watchPollInterval, err := getDuration(conf, "WATCH_POLL_INTERVAL", 30*time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("getDuration %w", err)
+	}
+
+	webhookWorkers, webhookPollInterval, err := newWebhookWorkersConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newWebhookWorkersConfig %w", err)
+	}
+
+	webhookConfig, err := newWebhookConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newWebhookConfig %w", err)
+	}
+
+	fetcherConfig, err := newFetcherConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newFetcherConfig %w", err)
+	}
+
+	linkCheckerConfig, err := newLinkCheckerConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newLinkCheckerConfig %w", err)
+	}
+
+	// links are checked with the same User-Agent robots.txt is honored for
+	linkCheckerConfig.UserAgent = fetcherConfig.UserAgent
+
+	politenessConfig, err := newPolitenessConfig(conf, fetcherConfig.UserAgent)
+	if err != nil {
+		return nil, fmt.Errorf("newPolitenessConfig %w", err)
+	}
+
+	analyzerConfig, err := newAnalyzerConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newAnalyzerConfig %w", err)
+	}
+
+	normalizerConfig, err := newNormalizerConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newNormalizerConfig %w", err)
+	}
+
+	cacheMaxAge, err := getDuration(conf, "CACHE_MAX_AGE", 0)
+	if err != nil {
+		return nil, fmt.Errorf("getDuration %w", err)
+	}
+
+	guardConfig, err := newGuardConfig(conf)
+	if err != nil {
+		return nil, fmt.Errorf("newGuardConfig %w", err)
+	}
+
+	guard, err := service.NewGuard(guardConfig)
+	if err != nil {
+		return nil, fmt.Errorf("service.NewGuard %w", err)
+	}
+
 	errC := make(chan error, 1)
 
-	srv := newServer(address, db, promExporter, otelmux.Middleware("url-api-server"), logging)
+	// every outgoing client connects within the connect timeout of the fetcher, their own timeouts bound the requests
+	connectTimeout := fetcherConfig.MaxConnectDuration()
+
+	jobRepo := postgresql.NewJob(db)
+	politeness := service.NewPoliteness(&http.Client{Transport: guard.Transport(connectTimeout)}, politenessConfig)
+	checker := service.NewLinkChecker(&http.Client{Transport: guard.Transport(connectTimeout)}, politeness, linkCheckerConfig)
+	normalizer := service.NewNormalizer(normalizerConfig)
+
+	analyzers, err := service.NewAnalyzerRegistry(analyzerConfig, service.DefaultAnalyzers(checker, analyzerConfig)...)
+	if err != nil {
+		return nil, fmt.Errorf("service.NewAnalyzerRegistry %w", err)
+	}
+
+	webhookSvc := service.NewWebhook(postgresql.NewWebhook(db), &http.Client{Transport: guard.Transport(connectTimeout)}, webhookConfig, logger)
+	svc := service.NewURL(postgresql.NewURL(db), jobRepo, normalizer, service.NewFetcher(guard, politeness, fetcherConfig), analyzers, cacheMaxAge, webhookSvc)
+	jobSvc := service.NewJob(jobRepo, svc, logger)
+
+	backfilled, err := svc.BackfillNormalizedURLs(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("svc.BackfillNormalizedURLs %w", err)
+	}
+
+	if backfilled > 0 {
+		logger.Info("Backfilled normalized URLs", zap.Int("count", backfilled))
+	}
+
+	watchSvc := service.NewWatch(postgresql.NewWatch(db), normalizer, logger)
+	crawlSvc := service.NewCrawl(postgresql.NewCrawl(db), svc, normalizer, logger)
+
+	writeTimeout := serverWriteTimeout(fetcherConfig, linkCheckerConfig)
+
+	srv := newServer(address, writeTimeout, svc, jobSvc, watchSvc, webhookSvc, crawlSvc, promExporter, otelmux.Middleware("url-api-server"), logging)
 
 	ctx, stop := signal.NotifyContext(context.Background(),
 		os.Interrupt,
 		syscall.SIGTERM,
 		syscall.SIGQUIT)
 
+	jobsDone := make(chan struct{})
+
+	go func() {
+		logger.Info("Running job workers", zap.Int("workers", workers))
+
+		jobSvc.Run(ctx, workers, pollInterval)
+
+		close(jobsDone)
+	}()
+
+	crawlsDone := make(chan struct{})
+
+	go func() {
+		logger.Info("Running crawl workers", zap.Int("workers", crawlWorkers))
+
+		crawlSvc.Run(ctx, crawlWorkers, crawlPollInterval)
+
+		close(crawlsDone)
+	}()
+
+	watchesDone := make(chan struct{})
+
+	go func() {
+		logger.Info("Running watch scheduler", zap.Duration("pollInterval", watchPollInterval))
+
+		watchSvc.Run(ctx, watchPollInterval)
+
+		close(watchesDone)
+	}()
+
+	webhooksDone := make(chan struct{})
+
+	go func() {
+		logger.Info("Running webhook dispatcher", zap.Int("workers", webhookWorkers))
+
+		webhookSvc.Run(ctx, webhookWorkers, webhookPollInterval)
+
+		close(webhooksDone)
+	}()
+
 	go func() {
 		<-ctx.Done()
 
 		logger.Info("Shutdown signal received")
 
-		ctxTimeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		// the synchronous searches in flight may take as long as the write timeout to complete
+		ctxTimeout, cancel := context.WithTimeout(context.Background(), writeTimeout)
 
 		defer func() {
+			<-jobsDone
+			<-crawlsDone
+			<-watchesDone
+			<-webhooksDone
+
+			// the analyses outlive their callers, they must be done before closing the database
+			if err := svc.Shutdown(ctxTimeout); err != nil {
+				logger.Error("Couldn't wait for the running analyses", zap.Error(err))
+			}
+
 			logger.Sync()
 			db.Close()
 			stop()
@@ -140,18 +292,29 @@ func run(env, address string) (<-chan error, error) {
 	return errC, nil
 }
 
-func newServer(address string, db *sql.DB, metrics http.Handler, mws ...mux.MiddlewareFunc) *http.Server {
+// serverWriteTimeoutMargin is the time left for waiting on politeness, running the other analyzers, storing
+// the analysis and writing the response
+const serverWriteTimeoutMargin = 10 * time.Second
+
+// serverWriteTimeout returns a write timeout long enough for a synchronous analysis: fetching the page and
+// checking its links, subresources are checked at the same time as links
+func serverWriteTimeout(fetcher service.FetcherConfig, checker service.LinkCheckerConfig) time.Duration {
+	return fetcher.MaxDuration() + checker.MaxDuration() + serverWriteTimeoutMargin
+}
+
+func newServer(address string, writeTimeout time.Duration, svc *service.URL, jobSvc *service.Job, watchSvc *service.Watch, webhookSvc *service.Webhook, crawlSvc *service.Crawl, metrics http.Handler, mws ...mux.MiddlewareFunc) *http.Server {
 	r := mux.NewRouter()
 
 	for _, mw := range mws {
 		r.Use(mw)
 	}
 
-	repo := postgresql.NewURL(db)
-	svc := service.NewURL(repo)
-
 	rest.RegisterOpenAPI(r)
 	rest.NewURLHandler(svc).Register(r)
+	rest.NewJobHandler(jobSvc).Register(r)
+	rest.NewWatchHandler(watchSvc).Register(r)
+	rest.NewWebhookHandler(webhookSvc).Register(r)
+	rest.NewCrawlHandler(crawlSvc).Register(r)
 
 	fsys, _ := fs.Sub(content, "static")
 	r.PathPrefix("/static/").Handler(http.StripPrefix("/static/", http.FileServer(http.FS(fsys))))
@@ -165,7 +328,7 @@ func newServer(address string, db *sql.DB, metrics http.Handler, mws ...mux.Midd
 		Addr:              address,
 		ReadTimeout:       1 * time.Second,
 		ReadHeaderTimeout: 1 * time.Second,
-		WriteTimeout:      1 * time.Second,
+		WriteTimeout:      writeTimeout,
 		IdleTimeout:       1 * time.Second,
 	}
 }
@@ -211,6 +374,286 @@ func newDB(conf *envvar.Configuration) (*sql.DB, error) {
 	return db, nil
 }
 
+func newJobConfig(conf *envvar.Configuration) (int, time.Duration, error) {
+	workers, err := getInt(conf, "JOB_WORKERS", 4)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pollInterval, err := getDuration(conf, "JOB_POLL_INTERVAL", time.Second)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return workers, pollInterval, nil
+}
+
+func newCrawlConfig(conf *envvar.Configuration) (int, time.Duration, error) {
+	workers, err := getInt(conf, "CRAWL_WORKERS", 1)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pollInterval, err := getDuration(conf, "CRAWL_POLL_INTERVAL", time.Second)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return workers, pollInterval, nil
+}
+
+func newWebhookWorkersConfig(conf *envvar.Configuration) (int, time.Duration, error) {
+	workers, err := getInt(conf, "WEBHOOK_WORKERS", 2)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pollInterval, err := getDuration(conf, "WEBHOOK_POLL_INTERVAL", time.Second)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return workers, pollInterval, nil
+}
+
+func newWebhookConfig(conf *envvar.Configuration) (service.WebhookConfig, error) {
+	timeout, err := getDuration(conf, "WEBHOOK_TIMEOUT", 0)
+	if err != nil {
+		return service.WebhookConfig{}, err
+	}
+
+	maxAttempts, err := getInt(conf, "WEBHOOK_MAX_ATTEMPTS", 0)
+	if err != nil {
+		return service.WebhookConfig{}, err
+	}
+
+	backoffBase, err := getDuration(conf, "WEBHOOK_BACKOFF_BASE", 0)
+	if err != nil {
+		return service.WebhookConfig{}, err
+	}
+
+	backoffMax, err := getDuration(conf, "WEBHOOK_BACKOFF_MAX", 0)
+	if err != nil {
+		return service.WebhookConfig{}, err
+	}
+
+	return service.WebhookConfig{
+		Timeout:     timeout,
+		MaxAttempts: maxAttempts,
+		BackoffBase: backoffBase,
+		BackoffMax:  backoffMax,
+	}, nil
+}
+
+func newLinkCheckerConfig(conf *envvar.Configuration) (service.LinkCheckerConfig, error) {
+	concurrency, err := getInt(conf, "LINK_CHECKER_CONCURRENCY", 0)
+	if err != nil {
+		return service.LinkCheckerConfig{}, err
+	}
+
+	timeout, err := getDuration(conf, "LINK_CHECKER_TIMEOUT", 0)
+	if err != nil {
+		return service.LinkCheckerConfig{}, err
+	}
+
+	deadline, err := getDuration(conf, "LINK_CHECKER_DEADLINE", 0)
+	if err != nil {
+		return service.LinkCheckerConfig{}, err
+	}
+
+	return service.LinkCheckerConfig{
+		Concurrency: concurrency,
+		Timeout:     timeout,
+		Deadline:    deadline,
+	}, nil
+}
+
+func newFetcherConfig(conf *envvar.Configuration) (service.FetcherConfig, error) {
+	connectTimeout, err := getDuration(conf, "FETCHER_CONNECT_TIMEOUT", 0)
+	if err != nil {
+		return service.FetcherConfig{}, err
+	}
+
+	timeout, err := getDuration(conf, "FETCHER_TIMEOUT", 0)
+	if err != nil {
+		return service.FetcherConfig{}, err
+	}
+
+	userAgent, err := conf.Get("FETCHER_USER_AGENT")
+	if err != nil {
+		return service.FetcherConfig{}, fmt.Errorf("conf.Get FETCHER_USER_AGENT %w", err)
+	}
+
+	maxBodyBytes, err := getInt(conf, "FETCHER_MAX_BODY_BYTES", 0)
+	if err != nil {
+		return service.FetcherConfig{}, err
+	}
+
+	maxRedirects, err := getInt(conf, "FETCHER_MAX_REDIRECTS", 0)
+	if err != nil {
+		return service.FetcherConfig{}, err
+	}
+
+	allowedSchemes, err := conf.Get("FETCHER_ALLOWED_SCHEMES")
+	if err != nil {
+		return service.FetcherConfig{}, fmt.Errorf("conf.Get FETCHER_ALLOWED_SCHEMES %w", err)
+	}
+
+	config := service.FetcherConfig{
+		ConnectTimeout: connectTimeout,
+		Timeout:        timeout,
+		UserAgent:      userAgent,
+		MaxBodyBytes:   int64(maxBodyBytes),
+		MaxRedirects:   maxRedirects,
+	}
+
+	if allowedSchemes != "" {
+		config.AllowedSchemes = strings.Split(allowedSchemes, ",")
+	}
+
+	return config, nil
+}
+
+func newNormalizerConfig(conf *envvar.Configuration) (service.NormalizerConfig, error) {
+	defaultScheme, err := conf.Get("NORMALIZER_DEFAULT_SCHEME")
+	if err != nil {
+		return service.NormalizerConfig{}, fmt.Errorf("conf.Get NORMALIZER_DEFAULT_SCHEME %w", err)
+	}
+
+	strippedParams, err := conf.Get("NORMALIZER_STRIPPED_PARAMS")
+	if err != nil {
+		return service.NormalizerConfig{}, fmt.Errorf("conf.Get NORMALIZER_STRIPPED_PARAMS %w", err)
+	}
+
+	config := service.NormalizerConfig{
+		DefaultScheme: defaultScheme,
+	}
+
+	if strippedParams != "" {
+		config.StrippedParams = strings.Split(strippedParams, ",")
+	}
+
+	return config, nil
+}
+
+func newPolitenessConfig(conf *envvar.Configuration, userAgent string) (service.PolitenessConfig, error) {
+	interval, err := getDuration(conf, "POLITENESS_INTERVAL", 0)
+	if err != nil {
+		return service.PolitenessConfig{}, err
+	}
+
+	burst, err := getInt(conf, "POLITENESS_BURST", 0)
+	if err != nil {
+		return service.PolitenessConfig{}, err
+	}
+
+	robotsMaxAge, err := getDuration(conf, "POLITENESS_ROBOTS_MAX_AGE", 0)
+	if err != nil {
+		return service.PolitenessConfig{}, err
+	}
+
+	exemptHosts, err := conf.Get("POLITENESS_EXEMPT_HOSTS")
+	if err != nil {
+		return service.PolitenessConfig{}, fmt.Errorf("conf.Get POLITENESS_EXEMPT_HOSTS %w", err)
+	}
+
+	return service.PolitenessConfig{
+		UserAgent:    userAgent,
+		Interval:     interval,
+		Burst:        burst,
+		RobotsMaxAge: robotsMaxAge,
+		ExemptHosts:  strings.Split(exemptHosts, ","),
+	}, nil
+}
+
+func newAnalyzerConfig(conf *envvar.Configuration) (service.AnalyzerConfig, error) {
+	disabled, err := conf.Get("ANALYZERS_DISABLED")
+	if err != nil {
+		return service.AnalyzerConfig{}, fmt.Errorf("conf.Get ANALYZERS_DISABLED %w", err)
+	}
+
+	checkSubresources, err := getBool(conf, "ANALYZERS_CHECK_SUBRESOURCES", false)
+	if err != nil {
+		return service.AnalyzerConfig{}, err
+	}
+
+	return service.AnalyzerConfig{
+		Disabled:          strings.Split(disabled, ","),
+		CheckSubresources: checkSubresources,
+	}, nil
+}
+
+func newGuardConfig(conf *envvar.Configuration) (service.GuardConfig, error) {
+	denied, err := conf.Get("SSRF_DENIED_CIDRS")
+	if err != nil {
+		return service.GuardConfig{}, fmt.Errorf("conf.Get SSRF_DENIED_CIDRS %w", err)
+	}
+
+	allowed, err := conf.Get("SSRF_ALLOWED_CIDRS")
+	if err != nil {
+		return service.GuardConfig{}, fmt.Errorf("conf.Get SSRF_ALLOWED_CIDRS %w", err)
+	}
+
+	return service.GuardConfig{
+		DeniedCIDRs:  strings.Split(denied, ","),
+		AllowedCIDRs: strings.Split(allowed, ","),
+	}, nil
+}
+
+func getInt(conf *envvar.Configuration, key string, def int) (int, error) {
+	val, err := conf.Get(key)
+	if err != nil {
+		return 0, fmt.Errorf("conf.Get %s %w", key, err)
+	}
+
+	if val == "" {
+		return def, nil
+	}
+
+	res, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, fmt.Errorf("strconv.Atoi %s %w", key, err)
+	}
+
+	return res, nil
+}
+
+func getBool(conf *envvar.Configuration, key string, def bool) (bool, error) {
+	val, err := conf.Get(key)
+	if err != nil {
+		return false, fmt.Errorf("conf.Get %s %w", key, err)
+	}
+
+	if val == "" {
+		return def, nil
+	}
+
+	res, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, fmt.Errorf("strconv.ParseBool %s %w", key, err)
+	}
+
+	return res, nil
+}
+
+func getDuration(conf *envvar.Configuration, key string, def time.Duration) (time.Duration, error) {
+	val, err := conf.Get(key)
+	if err != nil {
+		return 0, fmt.Errorf("conf.Get %s %w", key, err)
+	}
+
+	if val == "" {
+		return def, nil
+	}
+
+	res, err := time.ParseDuration(val)
+	if err != nil {
+		return 0, fmt.Errorf("time.ParseDuration %s %w", key, err)
+	}
+
+	return res, nil
+}
+
 func newVaultProvider() (*vault.Provider, error) {
 	vaultPath := os.Getenv("VAULT_PATH")
 	vaultToken := os.Getenv("VAULT_TOKEN")
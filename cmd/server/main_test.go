@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/service"
+)
+
+// urlRepository stores the created URLs in memory, the other methods are not used by synchronous searches
+type urlRepository struct {
+	service.URLRepository
+}
+
+func (urlRepository) Create(_ context.Context, URL internal.URL, _ []internal.Link) (internal.URL, error) {
+	URL.ID = "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"
+	return URL, nil
+}
+
+func (urlRepository) FindLatest(_ context.Context, _ string, _ time.Time) (internal.URL, error) {
+	return internal.URL{}, internal.NewErrorf(internal.ErrorCodeNotFound, "url not found")
+}
+
+// webhookRepository drops the events
+type webhookRepository struct {
+	service.WebhookRepository
+}
+
+func (webhookRepository) Enqueue(_ context.Context, _ internal.WebhookEvent, _ []byte) error {
+	return nil
+}
+
+func TestNewServer_SlowSearch(t *testing.T) {
+	t.Parallel()
+
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(1500 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<!DOCTYPE html><html><head><title>Slow</title></head><body></body></html>`))
+	}))
+	t.Cleanup(origin.Close)
+
+	guard, err := service.NewGuard(service.GuardConfig{
+		AllowedCIDRs: []string{"127.0.0.0/8", "::1/128"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	fetcherConfig := service.FetcherConfig{}
+	linkCheckerConfig := service.LinkCheckerConfig{}
+
+	checker := service.NewLinkChecker(http.DefaultClient, nil, linkCheckerConfig)
+
+	analyzers, err := service.NewAnalyzerRegistry(service.AnalyzerConfig{}, service.DefaultAnalyzers(checker, service.AnalyzerConfig{})...)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	webhooks := service.NewWebhook(webhookRepository{}, http.DefaultClient, service.WebhookConfig{}, zap.NewNop())
+	svc := service.NewURL(urlRepository{}, nil, service.NewNormalizer(service.NormalizerConfig{}),
+		service.NewFetcher(guard, nil, fetcherConfig), analyzers, 0, webhooks)
+
+	srv := newServer("", serverWriteTimeout(fetcherConfig, linkCheckerConfig), svc, nil, nil, nil, nil, http.NotFoundHandler())
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	go srv.Serve(lis)
+	t.Cleanup(func() { srv.Close() })
+
+	res, err := http.Post("http://"+lis.Addr().String()+"/URLs", "application/json",
+		strings.NewReader(`{"url": "`+origin.URL+`/"}`))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		t.Fatalf("expected code %d, actual %d", http.StatusCreated, res.StatusCode)
+	}
+}
@@ -0,0 +1,8 @@
+ALTER TABLE urls
+  DROP COLUMN url,
+  DROP COLUMN final_url,
+  DROP COLUMN status_code,
+  DROP COLUMN content_type,
+  DROP COLUMN content_length,
+  DROP COLUMN fetch_duration_ms,
+  DROP COLUMN created_at;
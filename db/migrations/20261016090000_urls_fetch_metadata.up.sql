@@ -0,0 +1,8 @@
+ALTER TABLE urls
+  ADD COLUMN url    VARCHAR NOT NULL DEFAULT '',
+  ADD COLUMN final_url    VARCHAR NOT NULL DEFAULT '',
+  ADD COLUMN status_code    INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN content_type    VARCHAR NOT NULL DEFAULT '',
+  ADD COLUMN content_length    BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN fetch_duration_ms    BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW();
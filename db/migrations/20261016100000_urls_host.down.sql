@@ -0,0 +1,6 @@
+DROP INDEX urls_inaccessible_links_count_id_idx;
+DROP INDEX urls_created_at_id_idx;
+DROP INDEX urls_host_idx;
+
+ALTER TABLE urls
+  DROP COLUMN host;
@@ -0,0 +1,9 @@
+ALTER TABLE urls
+  ADD COLUMN host    VARCHAR NOT NULL DEFAULT '';
+
+UPDATE urls
+SET host = COALESCE(LOWER(SUBSTRING(url FROM '^[a-zA-Z][a-zA-Z0-9+.-]*://(?:[^@/]*@)?([^:/?#]+)')), '');
+
+CREATE INDEX urls_host_idx ON urls (host);
+CREATE INDEX urls_created_at_id_idx ON urls (created_at, id);
+CREATE INDEX urls_inaccessible_links_count_id_idx ON urls (inaccessible_links_count, id);
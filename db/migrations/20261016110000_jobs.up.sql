@@ -0,0 +1,11 @@
+CREATE TABLE jobs (
+  id          UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  url    VARCHAR NOT NULL,
+  status    VARCHAR NOT NULL DEFAULT 'queued',
+  url_id    UUID REFERENCES urls (id) ON DELETE SET NULL,
+  error    VARCHAR NOT NULL DEFAULT '',
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX jobs_status_created_at_idx ON jobs (status, created_at);
@@ -0,0 +1,15 @@
+ALTER TABLE urls
+  ADD COLUMN headings_count    VARCHAR NOT NULL DEFAULT '';
+
+UPDATE urls
+SET headings_count = FORMAT('h1: %s  h2: %s  h3: %s  h4: %s  h5: %s  h6:   %s',
+  headings->>'h1',
+  headings->>'h2',
+  headings->>'h3',
+  headings->>'h4',
+  headings->>'h5',
+  headings->>'h6'
+);
+
+ALTER TABLE urls
+  DROP COLUMN headings;
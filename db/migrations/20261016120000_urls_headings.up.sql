@@ -0,0 +1,17 @@
+ALTER TABLE urls
+  ADD COLUMN headings    JSONB NOT NULL DEFAULT '{"h1": 0, "h2": 0, "h3": 0, "h4": 0, "h5": 0, "h6": 0, "outline": []}';
+
+-- legacy rows look like "h1: 2  h2: 0  h3: 0  h4: 0  h5: 0  h6:   1"
+UPDATE urls
+SET headings = JSONB_BUILD_OBJECT(
+  'h1', COALESCE(SUBSTRING(headings_count FROM 'h1:\s*(\d+)')::INTEGER, 0),
+  'h2', COALESCE(SUBSTRING(headings_count FROM 'h2:\s*(\d+)')::INTEGER, 0),
+  'h3', COALESCE(SUBSTRING(headings_count FROM 'h3:\s*(\d+)')::INTEGER, 0),
+  'h4', COALESCE(SUBSTRING(headings_count FROM 'h4:\s*(\d+)')::INTEGER, 0),
+  'h5', COALESCE(SUBSTRING(headings_count FROM 'h5:\s*(\d+)')::INTEGER, 0),
+  'h6', COALESCE(SUBSTRING(headings_count FROM 'h6:\s*(\d+)')::INTEGER, 0),
+  'outline', '[]'::JSONB
+);
+
+ALTER TABLE urls
+  DROP COLUMN headings_count;
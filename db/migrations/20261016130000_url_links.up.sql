@@ -0,0 +1,15 @@
+CREATE TABLE url_links (
+  id          BIGSERIAL PRIMARY KEY,
+  url_id    UUID NOT NULL REFERENCES urls (id) ON DELETE CASCADE,
+  position    INTEGER NOT NULL,
+  href    VARCHAR NOT NULL,
+  resolved_url    VARCHAR NOT NULL,
+  text    VARCHAR NOT NULL,
+  external    BOOLEAN NOT NULL,
+  status_class    VARCHAR NOT NULL,
+  status_code    INTEGER NOT NULL,
+  error    VARCHAR NOT NULL,
+  latency_ms    BIGINT NOT NULL
+);
+
+CREATE INDEX url_links_url_id_status_class_idx ON url_links (url_id, status_class);
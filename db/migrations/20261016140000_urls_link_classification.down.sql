@@ -0,0 +1,7 @@
+ALTER TABLE urls
+  DROP COLUMN internal_links_count,
+  DROP COLUMN external_links_count,
+  DROP COLUMN nofollow_links_count,
+  DROP COLUMN sponsored_links_count,
+  DROP COLUMN ugc_links_count,
+  DROP COLUMN unsafe_blank_links_count;
@@ -0,0 +1,21 @@
+ALTER TABLE urls
+  ADD COLUMN internal_links_count    INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN external_links_count    INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN nofollow_links_count    INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN sponsored_links_count    INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN ugc_links_count    INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN unsafe_blank_links_count    INTEGER NOT NULL DEFAULT 0;
+
+UPDATE urls SET
+  internal_links_count = counts.internal,
+  external_links_count = counts.external
+FROM (
+  SELECT
+    url_id,
+    COUNT(*) FILTER (WHERE NOT external) AS internal,
+    COUNT(*) FILTER (WHERE external) AS external
+  FROM url_links
+  WHERE status_class <> 'skipped'
+  GROUP BY url_id
+) AS counts
+WHERE urls.id = counts.url_id;
@@ -0,0 +1,2 @@
+ALTER TABLE urls
+  ADD COLUMN forms    JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,2 @@
+ALTER TABLE urls
+  ADD COLUMN doctype    JSONB NOT NULL DEFAULT '{}';
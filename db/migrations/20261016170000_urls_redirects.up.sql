@@ -0,0 +1,2 @@
+ALTER TABLE urls
+  ADD COLUMN redirects    JSONB NOT NULL DEFAULT '[]';
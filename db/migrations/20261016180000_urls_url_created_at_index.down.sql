@@ -0,0 +1 @@
+DROP INDEX urls_url_created_at_idx;
@@ -0,0 +1 @@
+CREATE INDEX urls_url_created_at_idx ON urls (url, created_at DESC);
@@ -0,0 +1,6 @@
+DROP INDEX urls_normalized_url_created_at_idx;
+
+CREATE INDEX urls_url_created_at_idx ON urls (url, created_at DESC);
+
+ALTER TABLE urls
+  DROP COLUMN normalized_url;
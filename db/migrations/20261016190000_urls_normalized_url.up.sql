@@ -0,0 +1,7 @@
+-- the existing records are normalized when the server starts, see URL.BackfillNormalizedURLs
+ALTER TABLE urls
+  ADD COLUMN normalized_url    VARCHAR NOT NULL DEFAULT '';
+
+DROP INDEX urls_url_created_at_idx;
+
+CREATE INDEX urls_normalized_url_created_at_idx ON urls (normalized_url, created_at DESC);
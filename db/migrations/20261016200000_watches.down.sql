@@ -0,0 +1,4 @@
+ALTER TABLE jobs
+  DROP COLUMN watch_id;
+
+DROP TABLE watches;
@@ -0,0 +1,15 @@
+CREATE TABLE watches (
+  id          UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  url    VARCHAR NOT NULL,
+  schedule    VARCHAR NOT NULL,
+  next_run_at    TIMESTAMPTZ NOT NULL,
+  last_run_at    TIMESTAMPTZ,
+  last_job_id    UUID REFERENCES jobs (id) ON DELETE SET NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX watches_next_run_at_idx ON watches (next_run_at);
+
+ALTER TABLE jobs
+  ADD COLUMN watch_id    UUID REFERENCES watches (id) ON DELETE SET NULL;
@@ -0,0 +1,24 @@
+CREATE TABLE webhooks (
+  id          UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  url    VARCHAR NOT NULL,
+  events    JSONB NOT NULL DEFAULT '[]',
+  secret    VARCHAR NOT NULL,
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE webhook_deliveries (
+  id          UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  webhook_id    UUID NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+  event    VARCHAR NOT NULL,
+  payload    JSONB NOT NULL,
+  status    VARCHAR NOT NULL DEFAULT 'pending',
+  attempts    INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  response_status    INTEGER NOT NULL DEFAULT 0,
+  error    VARCHAR NOT NULL DEFAULT '',
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX webhook_deliveries_status_next_attempt_at_idx ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX webhook_deliveries_webhook_id_created_at_idx ON webhook_deliveries (webhook_id, created_at DESC);
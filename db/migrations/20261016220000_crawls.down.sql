@@ -0,0 +1,3 @@
+DROP TABLE crawl_pages;
+
+DROP TABLE crawls;
@@ -0,0 +1,28 @@
+CREATE TABLE crawls (
+  id          UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  url    VARCHAR NOT NULL,
+  scope    VARCHAR NOT NULL,
+  max_depth    INTEGER NOT NULL,
+  max_pages    INTEGER NOT NULL,
+  status    VARCHAR NOT NULL DEFAULT 'queued',
+  error    VARCHAR NOT NULL DEFAULT '',
+  created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+  updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX crawls_status_created_at_idx ON crawls (status, created_at);
+
+CREATE TABLE crawl_pages (
+  id          BIGSERIAL PRIMARY KEY,
+  crawl_id    UUID NOT NULL REFERENCES crawls (id) ON DELETE CASCADE,
+  position    INTEGER NOT NULL,
+  url    VARCHAR NOT NULL,
+  depth    INTEGER NOT NULL,
+  url_id    UUID REFERENCES urls (id) ON DELETE SET NULL,
+  page_title    VARCHAR NOT NULL,
+  inaccessible_links_count    INTEGER NOT NULL,
+  have_login_form    BOOLEAN NOT NULL,
+  error    VARCHAR NOT NULL
+);
+
+CREATE UNIQUE INDEX crawl_pages_crawl_id_position_idx ON crawl_pages (crawl_id, position);
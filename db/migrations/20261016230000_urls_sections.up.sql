@@ -0,0 +1,2 @@
+ALTER TABLE urls
+  ADD COLUMN sections JSONB NOT NULL DEFAULT '{}';
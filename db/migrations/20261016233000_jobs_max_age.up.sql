@@ -0,0 +1,2 @@
+ALTER TABLE jobs
+  ADD COLUMN max_age    BIGINT;
@@ -16,10 +16,11 @@ require (
 	github.com/joho/godotenv v1.3.0
 	github.com/maxbrunsfeld/counterfeiter/v6 v6.4.1 // indirect
 	github.com/ory/dockertest/v3 v3.7.0
+	github.com/pkg/errors v0.9.1
 	go.opentelemetry.io/contrib/instrumentation/github.com/gorilla/mux/otelmux v0.21.0
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.19.0
 	go.opentelemetry.io/contrib/instrumentation/runtime v0.21.0
-	go.opentelemetry.io/otel v1.0.0-RC1 // indirect
+	go.opentelemetry.io/otel v1.0.0-RC1
 	go.opentelemetry.io/otel/exporters/jaeger v1.0.0-RC1
 	go.opentelemetry.io/otel/exporters/metric/prometheus v0.21.0
 	go.opentelemetry.io/otel/exporters/prometheus v0.21.0
@@ -31,4 +32,5 @@ require (
 	go.opentelemetry.io/otel/sdk/metric v0.21.0
 	go.opentelemetry.io/otel/trace v1.0.0-RC1
 	go.uber.org/zap v1.17.0
+	golang.org/x/net v0.0.0-20210331212208-0fccb6fa2b5c
 )
@@ -0,0 +1,88 @@
+package internal
+
+// AccessibilityRule is the accessibility check an issue fails
+type AccessibilityRule string
+
+const (
+	AccessibilityRuleImageAlt     AccessibilityRule = "image-alt"
+	AccessibilityRuleInputLabel   AccessibilityRule = "input-label"
+	AccessibilityRuleHTMLLang     AccessibilityRule = "html-lang"
+	AccessibilityRuleHeadingOrder AccessibilityRule = "heading-order"
+	AccessibilityRuleLinkName     AccessibilityRule = "link-name"
+	AccessibilityRuleButtonName   AccessibilityRule = "button-name"
+	AccessibilityRuleDuplicateID  AccessibilityRule = "duplicate-id"
+	AccessibilityRuleTableHeaders AccessibilityRule = "table-headers"
+)
+
+// Validate ...
+func (r AccessibilityRule) Validate() error {
+	switch r {
+	case AccessibilityRuleImageAlt, AccessibilityRuleInputLabel, AccessibilityRuleHTMLLang,
+		AccessibilityRuleHeadingOrder, AccessibilityRuleLinkName, AccessibilityRuleButtonName,
+		AccessibilityRuleDuplicateID, AccessibilityRuleTableHeaders:
+		return nil
+	}
+	return NewErrorf(ErrorCodeInvalidArgument, "unsupported rule %q", r)
+}
+
+// AccessibilitySeverity is how much an issue impacts the users of assistive technologies
+type AccessibilitySeverity string
+
+const (
+	AccessibilitySeverityCritical AccessibilitySeverity = "critical"
+	AccessibilitySeveritySerious  AccessibilitySeverity = "serious"
+	AccessibilitySeverityModerate AccessibilitySeverity = "moderate"
+	AccessibilitySeverityMinor    AccessibilitySeverity = "minor"
+)
+
+// Validate ...
+func (s AccessibilitySeverity) Validate() error {
+	switch s {
+	case AccessibilitySeverityCritical, AccessibilitySeveritySerious, AccessibilitySeverityModerate,
+		AccessibilitySeverityMinor:
+		return nil
+	}
+	return NewErrorf(ErrorCodeInvalidArgument, "unsupported severity %q", s)
+}
+
+// Accessibility is the static accessibility audit of a page, it's stored as the accessibility section of the
+// analysis
+type Accessibility struct {
+	Issues []AccessibilityIssue `json:"issues"`
+}
+
+// AccessibilityIssue is an element of a page failing an accessibility rule
+type AccessibilityIssue struct {
+	Rule     AccessibilityRule     `json:"rule"`
+	Severity AccessibilitySeverity `json:"severity"`
+	// Location is the CSS path of the element, "html > body > img:nth-of-type(2)"
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// AccessibilityIssuesParams defines the arguments used for filtering the accessibility issues of a URL, empty
+// values match all of them
+type AccessibilityIssuesParams struct {
+	Rule     AccessibilityRule
+	Severity AccessibilitySeverity
+}
+
+// Validate ...
+func (p AccessibilityIssuesParams) Validate() error {
+	if p.Rule != "" {
+		if err := p.Rule.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.Severity != "" {
+		if err := p.Severity.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match indicates whether the issue passes the filter
+func (p AccessibilityIssuesParams) Match(issue AccessibilityIssue) bool {
+	return (p.Rule == "" || p.Rule == issue.Rule) && (p.Severity == "" || p.Severity == issue.Severity)
+}
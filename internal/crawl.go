@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"time"
+)
+
+// CrawlScope defines which of the links found while crawling are followed
+type CrawlScope string
+
+const (
+	// CrawlScopeHost follows the links to the same host as the seed
+	CrawlScopeHost CrawlScope = "host"
+	// CrawlScopePathPrefix follows the links to the same host as the seed under the directory of its path
+	CrawlScopePathPrefix CrawlScope = "pathPrefix"
+)
+
+// Validate ...
+func (s CrawlScope) Validate() error {
+	switch s {
+	case CrawlScopeHost, CrawlScopePathPrefix:
+		return nil
+	}
+	return NewErrorf(ErrorCodeInvalidArgument, "unsupported scope %q", s)
+}
+
+const (
+	// MaxCrawlDepth is the maximum number of links followed from the seed to reach a page
+	MaxCrawlDepth = 10
+	// MaxCrawlPages is the maximum number of pages analyzed by one crawl
+	MaxCrawlPages = 500
+)
+
+// Crawl analyzes the pages of a site starting from the seed URL and following its internal links, it goes
+// through the same states as a Job
+type Crawl struct {
+	ID       string
+	URL      string
+	Scope    CrawlScope
+	MaxDepth int
+	MaxPages int
+	Status   JobStatus
+	// Pages lists the crawled pages in the order they were analyzed
+	Pages     []CrawlPage
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate ...
+func (c Crawl) Validate() error {
+	if c.URL == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "URL is required")
+	}
+	if err := c.Scope.Validate(); err != nil {
+		return err
+	}
+	if c.MaxDepth < 1 || c.MaxDepth > MaxCrawlDepth {
+		return NewErrorf(ErrorCodeInvalidArgument, "maxDepth must be between 1 and %d", MaxCrawlDepth)
+	}
+	if c.MaxPages < 1 || c.MaxPages > MaxCrawlPages {
+		return NewErrorf(ErrorCodeInvalidArgument, "maxPages must be between 1 and %d", MaxCrawlPages)
+	}
+	return nil
+}
+
+// Summary aggregates the results of the crawled pages
+func (c Crawl) Summary() CrawlSummary {
+	var summary CrawlSummary
+
+	for _, page := range c.Pages {
+		if page.Error != "" {
+			summary.PagesFailed++
+			continue
+		}
+
+		summary.PagesCrawled++
+		summary.BrokenLinksCount += page.InaccessibleLinksCount
+
+		if page.PageTitle == "" {
+			summary.PagesWithoutTitleCount++
+		}
+
+		if page.HaveLoginForm {
+			summary.PagesWithLoginFormCount++
+		}
+	}
+
+	return summary
+}
+
+// CrawlPage is one page reached by a crawl, URLID refers to its analysis and is empty when it failed
+type CrawlPage struct {
+	// URL is the normalized URL of the page
+	URL string
+	// Depth is the number of links followed from the seed to reach the page
+	Depth                  int
+	URLID                  string
+	PageTitle              string
+	InaccessibleLinksCount int
+	HaveLoginForm          bool
+	Error                  string
+}
+
+// CrawlSummary is the aggregate of the pages of a crawl, failed pages are only counted by PagesFailed
+type CrawlSummary struct {
+	PagesCrawled            int
+	PagesFailed             int
+	BrokenLinksCount        int
+	PagesWithoutTitleCount  int
+	PagesWithLoginFormCount int
+}
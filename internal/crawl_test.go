@@ -0,0 +1,112 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestCrawl_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.Crawl
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.Crawl{
+				URL:      "https://example.com",
+				Scope:    internal.CrawlScopeHost,
+				MaxDepth: 2,
+				MaxPages: 50,
+			},
+			false,
+		},
+		{
+			"ERR: URL",
+			internal.Crawl{
+				Scope:    internal.CrawlScopeHost,
+				MaxDepth: 2,
+				MaxPages: 50,
+			},
+			true,
+		},
+		{
+			"ERR: Scope",
+			internal.Crawl{
+				URL:      "https://example.com",
+				Scope:    "domain",
+				MaxDepth: 2,
+				MaxPages: 50,
+			},
+			true,
+		},
+		{
+			"ERR: MaxDepth",
+			internal.Crawl{
+				URL:      "https://example.com",
+				Scope:    internal.CrawlScopePathPrefix,
+				MaxDepth: internal.MaxCrawlDepth + 1,
+				MaxPages: 50,
+			},
+			true,
+		},
+		{
+			"ERR: MaxPages",
+			internal.Crawl{
+				URL:      "https://example.com",
+				Scope:    internal.CrawlScopeHost,
+				MaxDepth: 2,
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestCrawl_Summary(t *testing.T) {
+	t.Parallel()
+
+	crawl := internal.Crawl{
+		Pages: []internal.CrawlPage{
+			{URL: "https://example.com/", URLID: "a", PageTitle: "Home", InaccessibleLinksCount: 2},
+			{URL: "https://example.com/login", Depth: 1, URLID: "b", HaveLoginForm: true},
+			{URL: "https://example.com/about", Depth: 1, URLID: "c", PageTitle: "About", InaccessibleLinksCount: 1},
+			{URL: "https://example.com/logo.png", Depth: 1, Error: "unsupported content type"},
+		},
+	}
+
+	expected := internal.CrawlSummary{
+		PagesCrawled:            3,
+		PagesFailed:             1,
+		BrokenLinksCount:        3,
+		PagesWithoutTitleCount:  1,
+		PagesWithLoginFormCount: 1,
+	}
+
+	if actual := crawl.Summary(); !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
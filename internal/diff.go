@@ -0,0 +1,20 @@
+package internal
+
+// URLDiff lists what changed between two analyses of the same page
+type URLDiff struct {
+	From URL
+	To   URL
+	// Changes lists the fields whose value differs, in the order of the URL fields
+	Changes []FieldChange
+	// NewBrokenLinks lists the links broken in To that were reachable or missing in From
+	NewBrokenLinks []Link
+	// FixedLinks lists the links broken in From that are reachable in To
+	FixedLinks []Link
+}
+
+// FieldChange is the value of one field of URL in both analyses, Field is named as in the API
+type FieldChange struct {
+	Field string
+	From  interface{}
+	To    interface{}
+}
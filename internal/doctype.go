@@ -0,0 +1,18 @@
+package internal
+
+// DocumentMode is the rendering mode browsers pick from the doctype of a page
+type DocumentMode string
+
+const (
+	DocumentModeNoQuirks      DocumentMode = "noQuirks"
+	DocumentModeLimitedQuirks DocumentMode = "limitedQuirks"
+	DocumentModeQuirks        DocumentMode = "quirks"
+)
+
+// Doctype is the document type declaration of a page, Name is empty when the page has none
+type Doctype struct {
+	Name     string       `json:"name"`
+	PublicID string       `json:"publicID"`
+	SystemID string       `json:"systemID"`
+	Mode     DocumentMode `json:"mode"`
+}
@@ -7,9 +7,10 @@ import (
 // Error represents an error that could be wrapping another error, it includes a code for determining what
 // triggered the error
 type Error struct {
-	orig error
-	msg  string
-	code ErrorCode
+	orig           error
+	msg            string
+	code           ErrorCode
+	upstreamStatus int
 }
 
 // ErrorCode defines supported error codes
@@ -19,6 +20,16 @@ const (
 	ErrorCodeUnknown ErrorCode = iota
 	ErrorCodeNotFound
 	ErrorCodeInvalidArgument
+	ErrorCodeForbiddenTarget
+	ErrorCodeUpstreamUnreachable
+	ErrorCodeUpstreamStatus
+	ErrorCodeTimeout
+	ErrorCodeTooLarge
+	ErrorCodeUnsupportedContentType
+	ErrorCodeConflict
+	ErrorCodeUnauthorized
+	ErrorCodeRateLimited
+	ErrorCodeDisallowedByRobots
 )
 
 // WrapErrorf returns a wrapped error
@@ -35,6 +46,15 @@ func NewErrorf(code ErrorCode, format string, a ...interface{}) error {
 	return WrapErrorf(nil, code, format, a...)
 }
 
+// NewUpstreamStatusErrorf instantiates a new error triggered by an unexpected status code of the fetched server
+func NewUpstreamStatusErrorf(status int, format string, a ...interface{}) error {
+	return &Error{
+		code:           ErrorCodeUpstreamStatus,
+		msg:            fmt.Sprintf(format, a...),
+		upstreamStatus: status,
+	}
+}
+
 // Error returns the message, when wrapping errors the wrapped error is returned
 func (e *Error) Error() string {
 	if e.orig != nil {
@@ -53,3 +73,8 @@ func (e *Error) Unwrap() error {
 func (e *Error) Code() ErrorCode {
 	return e.code
 }
+
+// UpstreamStatus returns the status code of the fetched server that triggered this error, 0 if none
+func (e *Error) UpstreamStatus() int {
+	return e.upstreamStatus
+}
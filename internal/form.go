@@ -0,0 +1,27 @@
+package internal
+
+// FormKind classifies the purpose of a form
+type FormKind string
+
+const (
+	FormKindLogin         FormKind = "login"
+	FormKindSignup        FormKind = "signup"
+	FormKindPasswordReset FormKind = "passwordReset"
+	FormKindOther         FormKind = "other"
+)
+
+// Form is a form element of a page
+type Form struct {
+	Kind FormKind `json:"kind"`
+	// Action is the URL the form is submitted to, resolved against the page
+	Action string      `json:"action"`
+	Method string      `json:"method"`
+	Fields []FormField `json:"fields"`
+}
+
+// FormField is an input, select or textarea element of a form
+type FormField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"time"
+)
+
+// JobStatus defines the states an analysis job goes through
+type JobStatus string
+
+const (
+	JobStatusQueued    JobStatus = "queued"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// Job is an analysis of a URL executed in the background, URLID is set once it succeeds and WatchID when
+// it was enqueued by a watch
+type Job struct {
+	ID      string
+	URL     string
+	Status  JobStatus
+	URLID   string
+	WatchID string
+	// MaxAge is the one requested when enqueued, the server default applies when nil
+	MaxAge    *time.Duration
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
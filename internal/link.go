@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"fmt"
+	"time"
+)
+
+// Link is an anchor found in an analyzed page along with the result of checking it
+type Link struct {
+	Href        string
+	ResolvedURL string
+	Text        string
+	External    bool
+	Skipped     bool
+	StatusCode  int
+	Error       string
+	Latency     time.Duration
+}
+
+// LinkStatusClass groups links by the outcome of checking them
+type LinkStatusClass string
+
+const (
+	LinkStatusClass2xx     LinkStatusClass = "2xx"
+	LinkStatusClass3xx     LinkStatusClass = "3xx"
+	LinkStatusClass4xx     LinkStatusClass = "4xx"
+	LinkStatusClass5xx     LinkStatusClass = "5xx"
+	LinkStatusClassError   LinkStatusClass = "error"
+	LinkStatusClassSkipped LinkStatusClass = "skipped"
+)
+
+// Validate ...
+func (c LinkStatusClass) Validate() error {
+	switch c {
+	case LinkStatusClass2xx, LinkStatusClass3xx, LinkStatusClass4xx, LinkStatusClass5xx,
+		LinkStatusClassError, LinkStatusClassSkipped:
+		return nil
+	}
+	return NewErrorf(ErrorCodeInvalidArgument, "unsupported status class %q", c)
+}
+
+// StatusClass returns the class of the link, links without HTTP response are errors
+func (l Link) StatusClass() LinkStatusClass {
+	switch {
+	case l.Skipped:
+		return LinkStatusClassSkipped
+	case l.Error != "" || l.StatusCode < 200 || l.StatusCode > 599:
+		return LinkStatusClassError
+	}
+	return LinkStatusClass(fmt.Sprintf("%dxx", l.StatusCode/100))
+}
+
+// Broken indicates whether the link could not be reached, skipped links are never broken
+func (l Link) Broken() bool {
+	switch l.StatusClass() {
+	case LinkStatusClass4xx, LinkStatusClass5xx, LinkStatusClassError:
+		return true
+	}
+	return false
+}
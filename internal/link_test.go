@@ -0,0 +1,69 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestLink_StatusClass(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    internal.Link
+		expected internal.LinkStatusClass
+		broken   bool
+	}{
+		{
+			"2xx",
+			internal.Link{StatusCode: 204},
+			internal.LinkStatusClass2xx,
+			false,
+		},
+		{
+			"4xx",
+			internal.Link{StatusCode: 404},
+			internal.LinkStatusClass4xx,
+			true,
+		},
+		{
+			"error",
+			internal.Link{Error: "context deadline exceeded"},
+			internal.LinkStatusClassError,
+			true,
+		},
+		{
+			"skipped",
+			internal.Link{Href: "mailto:info@example.com", Skipped: true},
+			internal.LinkStatusClassSkipped,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := tt.input.StatusClass(); actual != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, actual)
+			}
+
+			if actual := tt.input.Broken(); actual != tt.broken {
+				t.Fatalf("expected broken %t, got %t", tt.broken, actual)
+			}
+
+			if err := tt.expected.Validate(); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		})
+	}
+
+	var ierr *internal.Error
+	if err := internal.LinkStatusClass("1xx").Validate(); !errors.As(err, &ierr) {
+		t.Fatalf("expected %T error, got %T", ierr, err)
+	}
+}
@@ -0,0 +1,36 @@
+package internal
+
+// MetadataWarning flags a problem of the metadata of a page
+type MetadataWarning string
+
+const (
+	MetadataWarningTitleMissing         MetadataWarning = "title.missing"
+	MetadataWarningTitleDuplicate       MetadataWarning = "title.duplicate"
+	MetadataWarningDescriptionMissing   MetadataWarning = "description.missing"
+	MetadataWarningDescriptionDuplicate MetadataWarning = "description.duplicate"
+)
+
+// Metadata is what the head of a page tells search engines, it's stored as the metadata section of the
+// analysis
+type Metadata struct {
+	Description string `json:"description"`
+	// DescriptionLength counts the characters of Description
+	DescriptionLength int `json:"descriptionLength"`
+	// Robots lists the directives of the robots meta tag, XRobotsTag the ones of the X-Robots-Tag headers
+	Robots     []string `json:"robots"`
+	XRobotsTag []string `json:"xRobotsTag"`
+	// Canonical is the canonical URL resolved against the page, CanonicalSelf is set when it's the page itself
+	Canonical     string     `json:"canonical"`
+	CanonicalSelf bool       `json:"canonicalSelf"`
+	Hreflang      []Hreflang `json:"hreflang"`
+	Viewport      string     `json:"viewport"`
+	// Charset is declared by the document, with a meta charset or http-equiv Content-Type
+	Charset  string            `json:"charset"`
+	Warnings []MetadataWarning `json:"warnings"`
+}
+
+// Hreflang is an alternate version of a page in another language or region
+type Hreflang struct {
+	Lang string `json:"lang"`
+	URL  string `json:"url"`
+}
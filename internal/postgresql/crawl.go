@@ -0,0 +1,234 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// Crawl represents the repository used for interacting with Crawl records
+type Crawl struct {
+	db *sql.DB
+	q  *Queries
+}
+
+// NewCrawl instantiates the Crawl repository
+func NewCrawl(db *sql.DB) *Crawl {
+	return &Crawl{
+		db: db,
+		q:  New(db),
+	}
+}
+
+// Create inserts a new queued Crawl record
+func (c *Crawl) Create(ctx context.Context, crawl internal.Crawl) (internal.Crawl, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res, err := c.q.InsertCrawl(ctx, InsertCrawlParams{
+		Url:      crawl.URL,
+		Scope:    string(crawl.Scope),
+		MaxDepth: int32(crawl.MaxDepth),
+		MaxPages: int32(crawl.MaxPages),
+	})
+	if err != nil {
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert crawl")
+	}
+	return newCrawl(res, nil), nil
+}
+
+// Delete deletes the existing record matching the id along with its pages, the analyses are kept
+func (c *Crawl) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	_, err = c.q.DeleteCrawl(ctx, val)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "crawl not found")
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "delete crawl")
+	}
+	return nil
+}
+
+// Find returns the requested Crawl by searching its id, along with its pages
+func (c *Crawl) Find(ctx context.Context, id string) (internal.Crawl, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	res, err := c.q.SelectCrawl(ctx, val)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "crawl not found")
+		}
+
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select crawl")
+	}
+	pages, err := c.q.SelectCrawlPages(ctx, val)
+	if err != nil {
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select crawl pages")
+	}
+	return newCrawl(res, pages), nil
+}
+
+// Claim marks the oldest queued Crawl as running and returns it, crawls without progress since staleBefore
+// are claimed again and start over. Concurrent callers never claim the same record.
+func (c *Crawl) Claim(ctx context.Context, staleBefore time.Time) (internal.Crawl, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Claim")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "begin transaction")
+	}
+	defer tx.Rollback()
+
+	q := c.q.WithTx(tx)
+
+	res, err := q.ClaimCrawl(ctx, staleBefore)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "no crawl queued")
+		}
+
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "claim crawl")
+	}
+
+	if err := q.DeleteCrawlPages(ctx, res.ID); err != nil {
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "delete crawl pages")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "commit transaction")
+	}
+
+	return newCrawl(res, nil), nil
+}
+
+// AddPage appends the page to the Crawl, it counts as progress for the staleness of the crawl
+func (c *Crawl) AddPage(ctx context.Context, id string, position int, page internal.CrawlPage) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.AddPage")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "begin transaction")
+	}
+	defer tx.Rollback()
+
+	q := c.q.WithTx(tx)
+
+	if err := q.InsertCrawlPage(ctx, InsertCrawlPageParams{
+		CrawlID:                val,
+		Position:               int32(position),
+		Url:                    page.URL,
+		Depth:                  int32(page.Depth),
+		UrlID:                  page.URLID,
+		PageTitle:              page.PageTitle,
+		InaccessibleLinksCount: int32(page.InaccessibleLinksCount),
+		HaveLoginForm:          page.HaveLoginForm,
+		Error:                  page.Error,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert crawl page")
+	}
+
+	if err := q.TouchCrawl(ctx, val); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "touch crawl")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "commit transaction")
+	}
+
+	return nil
+}
+
+// Complete marks the Crawl as succeeded
+func (c *Crawl) Complete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Complete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	if err := c.q.CompleteCrawl(ctx, val); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "complete crawl")
+	}
+	return nil
+}
+
+// Fail marks the Crawl as failed recording the reason
+func (c *Crawl) Fail(ctx context.Context, id string, msg string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Fail")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	if err := c.q.FailCrawl(ctx, FailCrawlParams{
+		Error: msg,
+		ID:    val,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "fail crawl")
+	}
+	return nil
+}
+
+func newCrawl(res Crawls, pages []CrawlPages) internal.Crawl {
+	crawl := internal.Crawl{
+		ID:        res.ID.String(),
+		URL:       res.Url,
+		Scope:     internal.CrawlScope(res.Scope),
+		MaxDepth:  int(res.MaxDepth),
+		MaxPages:  int(res.MaxPages),
+		Status:    internal.JobStatus(res.Status),
+		Pages:     make([]internal.CrawlPage, 0, len(pages)),
+		Error:     res.Error,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+	}
+
+	for _, page := range pages {
+		var URLID string
+		if page.UrlID != uuid.Nil {
+			URLID = page.UrlID.String()
+		}
+
+		crawl.Pages = append(crawl.Pages, internal.CrawlPage{
+			URL:                    page.Url,
+			Depth:                  int(page.Depth),
+			URLID:                  URLID,
+			PageTitle:              page.PageTitle,
+			InaccessibleLinksCount: int(page.InaccessibleLinksCount),
+			HaveLoginForm:          page.HaveLoginForm,
+			Error:                  page.Error,
+		})
+	}
+
+	return crawl
+}
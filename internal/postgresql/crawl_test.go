@@ -0,0 +1,127 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/postgresql"
+)
+
+func TestCrawl_Claim(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Claim: OK", func(t *testing.T) {
+		t.Parallel()
+
+		db := newDB(t)
+		store := postgresql.NewCrawl(db)
+
+		createdCrawl, err := store.Create(context.Background(), internal.Crawl{
+			URL:      "https://example.com",
+			Scope:    internal.CrawlScopeHost,
+			MaxDepth: 2,
+			MaxPages: 50,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		claimedCrawl, err := store.Claim(context.Background(), time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if claimedCrawl.ID != createdCrawl.ID || claimedCrawl.Status != internal.JobStatusRunning {
+			t.Fatalf("expected running crawl %s, got %+v", createdCrawl.ID, claimedCrawl)
+		}
+
+		_, err = store.Claim(context.Background(), time.Now().Add(-time.Hour))
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+
+		URL, err := postgresql.NewURL(db).Create(context.Background(), internal.URL{
+			URL:         "https://example.com",
+			HTMLVersion: "HTML 5",
+			PageTitle:   "asd",
+		}, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		pages := []internal.CrawlPage{
+			{URL: "https://example.com/", URLID: URL.ID, PageTitle: "asd", InaccessibleLinksCount: 1},
+			{URL: "https://example.com/logo.png", Depth: 1, Error: "unsupported content type"},
+		}
+
+		for i, page := range pages {
+			if err := store.AddPage(context.Background(), claimedCrawl.ID, i, page); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		if err := store.Complete(context.Background(), claimedCrawl.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actualCrawl, err := store.Find(context.Background(), createdCrawl.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actualCrawl.Status != internal.JobStatusSucceeded {
+			t.Fatalf("expected succeeded crawl, got %+v", actualCrawl)
+		}
+
+		if !cmp.Equal(pages, actualCrawl.Pages) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(pages, actualCrawl.Pages))
+		}
+	})
+}
+
+func TestCrawl_Find(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Find: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewCrawl(newDB(t))
+
+		createdCrawl, err := store.Create(context.Background(), internal.Crawl{
+			URL:      "https://example.com/docs/",
+			Scope:    internal.CrawlScopePathPrefix,
+			MaxDepth: 3,
+			MaxPages: 100,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actualCrawl, err := store.Find(context.Background(), createdCrawl.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(createdCrawl, actualCrawl) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(createdCrawl, actualCrawl))
+		}
+
+		if err := store.Delete(context.Background(), createdCrawl.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err = store.Find(context.Background(), createdCrawl.ID)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
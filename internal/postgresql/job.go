@@ -0,0 +1,152 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// Job represents the repository used for interacting with Job records
+type Job struct {
+	q *Queries
+}
+
+// NewJob instantiates the Job repository
+func NewJob(db *sql.DB) *Job {
+	return &Job{
+		q: New(db),
+	}
+}
+
+// Create inserts a new queued Job record analyzing the URL of params, MaxAge is stored in seconds
+func (j *Job) Create(ctx context.Context, params internal.SearchParams) (internal.Job, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("JobTracer").Start(ctx, "Job.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var maxAge sql.NullInt64
+	if params.MaxAge != nil {
+		maxAge = sql.NullInt64{Int64: int64(*params.MaxAge / time.Second), Valid: true}
+	}
+	res, err := j.q.InsertJob(ctx, InsertJobParams{
+		Url:    params.URL,
+		MaxAge: maxAge,
+	})
+	if err != nil {
+		return internal.Job{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert job")
+	}
+	return newJob(res), nil
+}
+
+// Claim marks the oldest queued Job as running and returns it, jobs left running since staleBefore are
+// claimed again. Concurrent callers never claim the same record.
+func (j *Job) Claim(ctx context.Context, staleBefore time.Time) (internal.Job, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("JobTracer").Start(ctx, "Job.Claim")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res, err := j.q.ClaimJob(ctx, staleBefore)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Job{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "no job queued")
+		}
+
+		return internal.Job{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "claim job")
+	}
+	return newJob(res), nil
+}
+
+// Complete marks the Job as succeeded linking it to the resulting URL record
+func (j *Job) Complete(ctx context.Context, id string, URLID string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("JobTracer").Start(ctx, "Job.Complete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	URLVal, err := uuid.Parse(URLID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid URL uuid")
+	}
+	if err := j.q.CompleteJob(ctx, CompleteJobParams{
+		UrlID: URLVal,
+		ID:    val,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "complete job")
+	}
+	return nil
+}
+
+// Fail marks the Job as failed recording the reason
+func (j *Job) Fail(ctx context.Context, id string, msg string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("JobTracer").Start(ctx, "Job.Fail")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	if err := j.q.FailJob(ctx, FailJobParams{
+		Error: msg,
+		ID:    val,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "fail job")
+	}
+	return nil
+}
+
+// Find returns the requested Job by searching its id
+func (j *Job) Find(ctx context.Context, id string) (internal.Job, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("JobTracer").Start(ctx, "Job.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Job{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	res, err := j.q.SelectJob(ctx, val)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Job{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "job not found")
+		}
+
+		return internal.Job{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select job")
+	}
+	return newJob(res), nil
+}
+
+func newJob(res Jobs) internal.Job {
+	var URLID string
+	if res.UrlID != uuid.Nil {
+		URLID = res.UrlID.String()
+	}
+
+	var watchID string
+	if res.WatchID != uuid.Nil {
+		watchID = res.WatchID.String()
+	}
+
+	var maxAge *time.Duration
+	if res.MaxAge.Valid {
+		val := time.Duration(res.MaxAge.Int64) * time.Second
+		maxAge = &val
+	}
+
+	return internal.Job{
+		ID:        res.ID.String(),
+		URL:       res.Url,
+		Status:    internal.JobStatus(res.Status),
+		URLID:     URLID,
+		WatchID:   watchID,
+		MaxAge:    maxAge,
+		Error:     res.Error,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+	}
+}
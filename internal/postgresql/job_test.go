@@ -0,0 +1,114 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/postgresql"
+)
+
+func TestJob_Claim(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Claim: OK", func(t *testing.T) {
+		t.Parallel()
+
+		db := newDB(t)
+		store := postgresql.NewJob(db)
+
+		maxAge := time.Minute
+
+		createdJob, err := store.Create(context.Background(), internal.SearchParams{
+			URL:    "https://example.com",
+			MaxAge: &maxAge,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		claimedJob, err := store.Claim(context.Background(), time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if claimedJob.ID != createdJob.ID || claimedJob.Status != internal.JobStatusRunning {
+			t.Fatalf("expected running job %s, got %+v", createdJob.ID, claimedJob)
+		}
+
+		if claimedJob.MaxAge == nil || *claimedJob.MaxAge != maxAge {
+			t.Fatalf("expected max age %s, got %v", maxAge, claimedJob.MaxAge)
+		}
+
+		_, err = store.Claim(context.Background(), time.Now().Add(-time.Hour))
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+
+		URL, err := postgresql.NewURL(db).Create(context.Background(), internal.URL{
+			URL:         "https://example.com",
+			HTMLVersion: "HTML 5",
+			PageTitle:   "asd",
+			Headings:    internal.Headings{H1: 1, Outline: []internal.Heading{{Level: 1, Text: "asd"}}},
+		}, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := store.Complete(context.Background(), claimedJob.ID, URL.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actualJob, err := store.Find(context.Background(), createdJob.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actualJob.Status != internal.JobStatusSucceeded || actualJob.URLID != URL.ID {
+			t.Fatalf("expected succeeded job with URL %s, got %+v", URL.ID, actualJob)
+		}
+	})
+
+	t.Run("Claim: OK stale", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewJob(newDB(t))
+
+		createdJob, err := store.Create(context.Background(), internal.SearchParams{URL: "https://example.com"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if _, err := store.Claim(context.Background(), time.Now().Add(-time.Hour)); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		claimedJob, err := store.Claim(context.Background(), time.Now().Add(time.Hour))
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if claimedJob.ID != createdJob.ID {
+			t.Fatalf("expected stale job %s to be claimed again, got %s", createdJob.ID, claimedJob.ID)
+		}
+	})
+}
+
+func TestJob_Find(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Find: ERR not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := postgresql.NewJob(newDB(t)).Find(context.Background(), "44633fe3-b039-4fb3-a35f-a57fe3c906c7")
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
@@ -3,15 +3,122 @@
 package postgresql
 
 import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
 	"github.com/google/uuid"
 )
 
+type CrawlPages struct {
+	ID                     int64
+	CrawlID                uuid.UUID
+	Position               int32
+	Url                    string
+	Depth                  int32
+	UrlID                  uuid.UUID
+	PageTitle              string
+	InaccessibleLinksCount int32
+	HaveLoginForm          bool
+	Error                  string
+}
+
+type Crawls struct {
+	ID        uuid.UUID
+	Url       string
+	Scope     string
+	MaxDepth  int32
+	MaxPages  int32
+	Status    string
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Jobs struct {
+	ID        uuid.UUID
+	Url       string
+	Status    string
+	UrlID     uuid.UUID
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	WatchID   uuid.UUID
+	MaxAge    sql.NullInt64
+}
+
+type UrlLinks struct {
+	ID          int64
+	UrlID       uuid.UUID
+	Position    int32
+	Href        string
+	ResolvedUrl string
+	Text        string
+	External    bool
+	StatusClass string
+	StatusCode  int32
+	Error       string
+	LatencyMs   int64
+}
+
 type Urls struct {
 	ID                     uuid.UUID
 	HtmlVersion            string
 	PageTitle              string
-	HeadingsCount          string
 	LinksCount             int32
 	InaccessibleLinksCount int32
 	HaveLoginForm          bool
+	Url                    string
+	FinalUrl               string
+	StatusCode             int32
+	ContentType            string
+	ContentLength          int64
+	FetchDurationMs        int64
+	CreatedAt              time.Time
+	Host                   string
+	Headings               json.RawMessage
+	InternalLinksCount     int32
+	ExternalLinksCount     int32
+	NofollowLinksCount     int32
+	SponsoredLinksCount    int32
+	UgcLinksCount          int32
+	UnsafeBlankLinksCount  int32
+	Forms                  json.RawMessage
+	Doctype                json.RawMessage
+	Redirects              json.RawMessage
+	NormalizedUrl          string
+	Sections               json.RawMessage
+}
+
+type Watches struct {
+	ID        uuid.UUID
+	Url       string
+	Schedule  string
+	NextRunAt time.Time
+	LastRunAt sql.NullTime
+	LastJobID uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDeliveries struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	Event          string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int32
+	NextAttemptAt  time.Time
+	ResponseStatus int32
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type Webhooks struct {
+	ID        uuid.UUID
+	Url       string
+	Events    json.RawMessage
+	Secret    string
+	CreatedAt time.Time
 }
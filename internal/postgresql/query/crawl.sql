@@ -0,0 +1,83 @@
+-- name: SelectCrawl :one
+SELECT * FROM crawls
+WHERE id = @id LIMIT 1;
+
+-- name: SelectCrawlPages :many
+SELECT * FROM crawl_pages
+WHERE crawl_id = @crawl_id
+ORDER BY position;
+
+-- name: InsertCrawl :one
+INSERT INTO crawls (
+  url,
+  scope,
+  max_depth,
+  max_pages
+)
+VALUES (
+  @url,
+  @scope,
+  @max_depth,
+  @max_pages
+)
+RETURNING *;
+
+-- name: DeleteCrawl :one
+DELETE FROM crawls
+WHERE id = @id RETURNING id AS res;
+
+-- name: ClaimCrawl :one
+UPDATE crawls
+SET status = 'running', updated_at = NOW()
+WHERE id = (
+  SELECT c.id FROM crawls c
+  WHERE c.status = 'queued'
+    OR (c.status = 'running' AND c.updated_at < @stale_before)
+  ORDER BY c.created_at
+  FOR UPDATE SKIP LOCKED
+  LIMIT 1
+)
+RETURNING *;
+
+-- name: DeleteCrawlPages :exec
+DELETE FROM crawl_pages
+WHERE crawl_id = @crawl_id;
+
+-- name: InsertCrawlPage :exec
+INSERT INTO crawl_pages (
+  crawl_id,
+  position,
+  url,
+  depth,
+  url_id,
+  page_title,
+  inaccessible_links_count,
+  have_login_form,
+  error
+)
+VALUES (
+  @crawl_id,
+  @position,
+  @url,
+  @depth,
+  NULLIF(@url_id::varchar, '')::uuid,
+  @page_title,
+  @inaccessible_links_count,
+  @have_login_form,
+  @error
+);
+
+-- name: TouchCrawl :exec
+UPDATE crawls
+SET updated_at = NOW()
+WHERE id = @id;
+
+-- name: CompleteCrawl :exec
+UPDATE crawls
+SET status = 'succeeded', updated_at = NOW()
+WHERE id = @id;
+
+-- name: FailCrawl :exec
+UPDATE crawls
+SET status = 'failed', error = @error, updated_at = NOW()
+WHERE id = @id;
@@ -0,0 +1,48 @@
+-- name: SelectJob :one
+SELECT * FROM jobs
+WHERE id = @id LIMIT 1;
+
+-- name: InsertJob :one
+INSERT INTO jobs (
+  url,
+  max_age
+)
+VALUES (
+  @url,
+  @max_age
+)
+RETURNING *;
+
+-- name: InsertWatchJob :one
+INSERT INTO jobs (
+  url,
+  watch_id
+)
+VALUES (
+  @url,
+  @watch_id
+)
+RETURNING *;
+
+-- name: ClaimJob :one
+UPDATE jobs
+SET status = 'running', updated_at = NOW()
+WHERE id = (
+  SELECT j.id FROM jobs j
+  WHERE j.status = 'queued'
+    OR (j.status = 'running' AND j.updated_at < @stale_before)
+  ORDER BY j.created_at
+  FOR UPDATE SKIP LOCKED
+  LIMIT 1
+)
+RETURNING *;
+
+-- name: CompleteJob :exec
+UPDATE jobs
+SET status = 'succeeded', url_id = @url_id, updated_at = NOW()
+WHERE id = @id;
+
+-- name: FailJob :exec
+UPDATE jobs
+SET status = 'failed', error = @error, updated_at = NOW()
+WHERE id = @id;
@@ -0,0 +1,31 @@
+-- name: InsertLink :exec
+INSERT INTO url_links (
+  url_id,
+  position,
+  href,
+  resolved_url,
+  text,
+  external,
+  status_class,
+  status_code,
+  error,
+  latency_ms
+)
+VALUES (
+  @url_id,
+  @position,
+  @href,
+  @resolved_url,
+  @text,
+  @external,
+  @status_class,
+  @status_code,
+  @error,
+  @latency_ms
+);
+
+-- name: SelectLinks :many
+SELECT * FROM url_links
+WHERE url_id = @url_id
+  AND (@status_class::varchar = '' OR status_class = @status_class)
+ORDER BY position;
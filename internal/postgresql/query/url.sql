@@ -2,25 +2,109 @@
 SELECT * FROM urls
 WHERE id = @id LIMIT 1;
 
+-- name: SelectLatestURL :one
+SELECT * FROM urls
+WHERE normalized_url = @normalized_url
+  AND created_at >= @created_from
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
+
+-- name: SelectURLsByNormalizedURL :many
+SELECT * FROM urls
+WHERE normalized_url = @normalized_url
+ORDER BY created_at, id;
+
 -- name: InsertURL :one
 INSERT INTO urls (
+  url,
+  normalized_url,
+  host,
+  final_url,
+  redirects,
+  status_code,
+  content_type,
+  content_length,
+  fetch_duration_ms,
   HTML_version,
+  doctype,
   page_title,
-  headings_count,
+  headings,
   links_count,
   inaccessible_links_count,
-  have_login_form
+  internal_links_count,
+  external_links_count,
+  nofollow_links_count,
+  sponsored_links_count,
+  ugc_links_count,
+  unsafe_blank_links_count,
+  have_login_form,
+  forms,
+  sections,
+  created_at
 )
 VALUES (
+  @URL,
+  @normalizedURL,
+  @host,
+  @finalURL,
+  @redirects,
+  @statusCode,
+  @contentType,
+  @contentLength,
+  @fetchDurationMs,
   @HTMLVersion,
+  @doctype,
   @pageTitle,
-  @headingsCount,
+  @headings,
   @linksCount,
   @inaccessibleLinksCount,
-  @haveLoginForm
+  @internalLinksCount,
+  @externalLinksCount,
+  @nofollowLinksCount,
+  @sponsoredLinksCount,
+  @ugcLinksCount,
+  @unsafeBlankLinksCount,
+  @haveLoginForm,
+  @forms,
+  @sections,
+  @createdAt
 )
-RETURNING id;
+RETURNING id, created_at;
 
 -- name: DeleteURL :one
 DELETE FROM urls
 WHERE  id = @id RETURNING id AS res;
+
+-- name: ListURLsByCreatedAt :many
+SELECT * FROM urls
+WHERE (@host::varchar = '' OR host = @host)
+  AND (@html_version::varchar = '' OR html_version = @html_version)
+  AND (NOT @filter_login_form::boolean OR have_login_form = @have_login_form::boolean)
+  AND created_at >= @created_from
+  AND created_at < @created_to
+  AND (created_at, id) < (@cursor_created_at::timestamptz, @cursor_id::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
+
+-- name: ListURLsByInaccessibleLinksCount :many
+SELECT * FROM urls
+WHERE (@host::varchar = '' OR host = @host)
+  AND (@html_version::varchar = '' OR html_version = @html_version)
+  AND (NOT @filter_login_form::boolean OR have_login_form = @have_login_form::boolean)
+  AND created_at >= @created_from
+  AND created_at < @created_to
+  AND (inaccessible_links_count, id) < (@cursor_count::integer, @cursor_id::uuid)
+ORDER BY inaccessible_links_count DESC, id DESC
+LIMIT @row_limit;
+
+-- name: SelectUnnormalizedURLs :many
+SELECT id, url FROM urls
+WHERE normalized_url = ''
+  AND id > @after_id::uuid
+ORDER BY id
+LIMIT @row_limit;
+
+-- name: UpdateURLNormalizedURL :exec
+UPDATE urls
+SET normalized_url = @normalized_url
+WHERE id = @id;
@@ -0,0 +1,53 @@
+-- name: SelectWatch :one
+SELECT w.*,
+  COALESCE(j.status, '')::varchar AS last_status,
+  COALESCE(j.url_id::varchar, '')::varchar AS last_url_id,
+  COALESCE(j.error, '')::varchar AS last_error
+FROM watches w
+LEFT JOIN jobs j ON j.id = w.last_job_id
+WHERE w.id = @id LIMIT 1;
+
+-- name: ListWatches :many
+SELECT w.*,
+  COALESCE(j.status, '')::varchar AS last_status,
+  COALESCE(j.url_id::varchar, '')::varchar AS last_url_id,
+  COALESCE(j.error, '')::varchar AS last_error
+FROM watches w
+LEFT JOIN jobs j ON j.id = w.last_job_id
+ORDER BY w.created_at, w.id;
+
+-- name: InsertWatch :one
+INSERT INTO watches (
+  url,
+  schedule,
+  next_run_at
+)
+VALUES (
+  @url,
+  @schedule,
+  @next_run_at
+)
+RETURNING *;
+
+-- name: DeleteWatch :one
+DELETE FROM watches
+WHERE id = @id RETURNING id AS res;
+
+-- name: TryLockWatches :one
+SELECT pg_try_advisory_xact_lock(@lock_id::bigint)::boolean AS locked;
+
+-- name: SelectDueWatches :many
+SELECT * FROM watches
+WHERE next_run_at <= @now
+ORDER BY next_run_at
+LIMIT @row_limit;
+
+-- name: PostponeWatch :exec
+UPDATE watches
+SET next_run_at = @next_run_at, updated_at = NOW()
+WHERE id = @id;
+
+-- name: UpdateWatchRun :exec
+UPDATE watches
+SET next_run_at = @next_run_at, last_run_at = @last_run_at, last_job_id = @last_job_id, updated_at = NOW()
+WHERE id = @id;
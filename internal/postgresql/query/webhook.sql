@@ -0,0 +1,60 @@
+-- name: SelectWebhook :one
+SELECT * FROM webhooks
+WHERE id = @id LIMIT 1;
+
+-- name: ListWebhooks :many
+SELECT * FROM webhooks
+ORDER BY created_at, id;
+
+-- name: InsertWebhook :one
+INSERT INTO webhooks (
+  url,
+  events,
+  secret
+)
+VALUES (
+  @url,
+  @events,
+  @secret
+)
+RETURNING *;
+
+-- name: DeleteWebhook :one
+DELETE FROM webhooks
+WHERE id = @id RETURNING id AS res;
+
+-- name: InsertWebhookDeliveries :exec
+INSERT INTO webhook_deliveries (
+  webhook_id,
+  event,
+  payload
+)
+SELECT w.id, @event::varchar, @payload::jsonb
+FROM webhooks w
+WHERE w.events @> to_jsonb(@event::varchar);
+
+-- name: ClaimWebhookDelivery :one
+UPDATE webhook_deliveries d
+SET attempts = d.attempts + 1, next_attempt_at = @lease_until, updated_at = NOW()
+FROM webhooks w
+WHERE w.id = d.webhook_id
+  AND d.id = (
+    SELECT p.id FROM webhook_deliveries p
+    WHERE p.status = 'pending'
+      AND p.next_attempt_at <= @now
+    ORDER BY p.next_attempt_at
+    FOR UPDATE SKIP LOCKED
+    LIMIT 1
+  )
+RETURNING d.*, w.url AS webhook_url, w.secret AS webhook_secret;
+
+-- name: UpdateWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = @status, next_attempt_at = @next_attempt_at, response_status = @response_status, error = @error, updated_at = NOW()
+WHERE id = @id;
+
+-- name: SelectWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE webhook_id = @webhook_id
+ORDER BY created_at DESC, id DESC
+LIMIT @row_limit;
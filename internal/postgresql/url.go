@@ -3,7 +3,14 @@ package postgresql
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"go.opentelemetry.io/otel/attribute"
@@ -14,43 +21,127 @@ import (
 
 // URL represents the repository used for interacting with URL records
 type URL struct {
-	q *Queries
+	db *sql.DB
+	q  *Queries
 }
 
 // NewURL instantiates the URL repository
 func NewURL(db *sql.DB) *URL {
 	return &URL{
-		q: New(db),
+		db: db,
+		q:  New(db),
 	}
 }
 
-// Create inserts a new ıser record
-func (u *URL) Create(ctx context.Context, HTMLVersion string, pageTitle string, headingsCount string, linksCount int,
-	inaccessibleLinksCount int, haveLoginForm bool) (internal.URL, error) {
+// Create inserts a new URL record together with its checked links
+func (u *URL) Create(ctx context.Context, URL internal.URL, links []internal.Link) (internal.URL, error) {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.Create")
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 	defer span.End()
-	id, err := u.q.InsertURL(ctx, InsertURLParams{
-		Htmlversion:            HTMLVersion,
-		Pagetitle:              pageTitle,
-		Headingscount:          headingsCount,
-		Linkscount:             int32(linksCount),
-		Inaccessiblelinkscount: int32(inaccessibleLinksCount),
-		Haveloginform:          haveLoginForm,
+	headings, err := json.Marshal(URL.Headings)
+	if err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "marshal headings")
+	}
+	if URL.Redirects == nil {
+		URL.Redirects = []internal.Redirect{}
+	}
+	redirects, err := json.Marshal(URL.Redirects)
+	if err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "marshal redirects")
+	}
+	doctype, err := json.Marshal(URL.Doctype)
+	if err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "marshal doctype")
+	}
+	if URL.Forms == nil {
+		URL.Forms = []internal.Form{}
+	}
+	forms, err := json.Marshal(URL.Forms)
+	if err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "marshal forms")
+	}
+	if URL.Sections == nil {
+		URL.Sections = map[string]json.RawMessage{}
+	}
+	sections, err := json.Marshal(URL.Sections)
+	if err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "marshal sections")
+	}
+
+	if URL.NormalizedURL == "" {
+		URL.NormalizedURL = URL.URL
+	}
+
+	if URL.CreatedAt.IsZero() {
+		URL.CreatedAt = time.Now()
+	}
+
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "begin transaction")
+	}
+	defer tx.Rollback()
+
+	q := u.q.WithTx(tx)
+
+	res, err := q.InsertURL(ctx, InsertURLParams{
+		Url:                    URL.URL,
+		Normalizedurl:          URL.NormalizedURL,
+		Host:                   hostname(URL.NormalizedURL),
+		Finalurl:               URL.FinalURL,
+		Redirects:              redirects,
+		Statuscode:             int32(URL.StatusCode),
+		Contenttype:            URL.ContentType,
+		Contentlength:          URL.ContentLength,
+		Fetchdurationms:        URL.FetchDuration.Milliseconds(),
+		Htmlversion:            URL.HTMLVersion,
+		Doctype:                doctype,
+		Pagetitle:              URL.PageTitle,
+		Headings:               headings,
+		Linkscount:             int32(URL.LinksCount),
+		Inaccessiblelinkscount: int32(URL.InaccessibleLinksCount),
+		Internallinkscount:     int32(URL.InternalLinksCount),
+		Externallinkscount:     int32(URL.ExternalLinksCount),
+		Nofollowlinkscount:     int32(URL.NofollowLinksCount),
+		Sponsoredlinkscount:    int32(URL.SponsoredLinksCount),
+		Ugclinkscount:          int32(URL.UGCLinksCount),
+		Unsafeblanklinkscount:  int32(URL.UnsafeBlankLinksCount),
+		Haveloginform:          URL.HaveLoginForm,
+		Forms:                  forms,
+		Sections:               sections,
+		Createdat:              URL.CreatedAt,
 	})
 	if err != nil {
 		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert URL")
 	}
 
-	return internal.URL{
-		ID:                     id.String(),
-		HTMLVersion:            HTMLVersion,
-		PageTitle:              pageTitle,
-		HeadingsCount:          headingsCount,
-		LinksCount:             linksCount,
-		InaccessibleLinksCount: inaccessibleLinksCount,
-		HaveLoginForm:          haveLoginForm,
-	}, nil
+	for i, link := range links {
+		err := q.InsertLink(ctx, InsertLinkParams{
+			UrlID:       res.ID,
+			Position:    int32(i),
+			Href:        link.Href,
+			ResolvedUrl: link.ResolvedURL,
+			Text:        link.Text,
+			External:    link.External,
+			StatusClass: string(link.StatusClass()),
+			StatusCode:  int32(link.StatusCode),
+			Error:       link.Error,
+			LatencyMs:   link.Latency.Milliseconds(),
+		})
+		if err != nil {
+			return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert link")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "commit transaction")
+	}
+
+	URL.ID = res.ID.String()
+	URL.FetchDuration = URL.FetchDuration.Truncate(time.Millisecond)
+	URL.CreatedAt = res.CreatedAt
+
+	return URL, nil
 }
 
 // Delete deletes the existing record matching the id
@@ -90,13 +181,321 @@ func (u *URL) Find(ctx context.Context, id string) (internal.URL, error) {
 
 		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select URL")
 	}
+	return newURL(res)
+}
+
+// FindLatest returns the most recent record of the normalized URL created since the given time
+func (u *URL) FindLatest(ctx context.Context, normalizedURL string, since time.Time) (internal.URL, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.FindLatest")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res, err := u.q.SelectLatestURL(ctx, SelectLatestURLParams{
+		NormalizedUrl: normalizedURL,
+		CreatedFrom:   since,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "URL not found")
+		}
+
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select latest URL")
+	}
+	return newURL(res)
+}
+
+// ListUnnormalized returns up to limit records stored without a normalized URL ordered by id, starting after
+// afterID when set. Only the ID and URL are loaded.
+func (u *URL) ListUnnormalized(ctx context.Context, afterID string, limit int) ([]internal.URL, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.ListUnnormalized")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	var after uuid.UUID
+	if afterID != "" {
+		val, err := uuid.Parse(afterID)
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+		}
+		after = val
+	}
+	rows, err := u.q.SelectUnnormalizedURLs(ctx, SelectUnnormalizedURLsParams{
+		AfterID:  after,
+		RowLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select unnormalized URLs")
+	}
+
+	URLs := make([]internal.URL, 0, len(rows))
+	for _, row := range rows {
+		URLs = append(URLs, internal.URL{
+			ID:  row.ID.String(),
+			URL: row.Url,
+		})
+	}
+
+	return URLs, nil
+}
+
+// UpdateNormalizedURL sets the normalized URL of the record matching the id
+func (u *URL) UpdateNormalizedURL(ctx context.Context, id string, normalizedURL string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.UpdateNormalizedURL")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	if err := u.q.UpdateURLNormalizedURL(ctx, UpdateURLNormalizedURLParams{
+		NormalizedUrl: normalizedURL,
+		ID:            val,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "update normalized URL")
+	}
+	return nil
+}
+
+// History returns all the records of the normalized URL, oldest first
+func (u *URL) History(ctx context.Context, normalizedURL string) ([]internal.URL, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.History")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	rows, err := u.q.SelectURLsByNormalizedURL(ctx, normalizedURL)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select URLs by normalized URL")
+	}
+
+	URLs := make([]internal.URL, 0, len(rows))
+	for _, row := range rows {
+		URL, err := newURL(row)
+		if err != nil {
+			return nil, err
+		}
+
+		URLs = append(URLs, URL)
+	}
+
+	return URLs, nil
+}
+
+// FindLinks returns the checked links of the URL matching the id, an empty class returns all of them
+func (u *URL) FindLinks(ctx context.Context, id string, class internal.LinkStatusClass) ([]internal.Link, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.FindLinks")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	rows, err := u.q.SelectLinks(ctx, SelectLinksParams{
+		UrlID:       val,
+		StatusClass: string(class),
+	})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select links")
+	}
+
+	links := make([]internal.Link, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, internal.Link{
+			Href:        row.Href,
+			ResolvedURL: row.ResolvedUrl,
+			Text:        row.Text,
+			External:    row.External,
+			Skipped:     row.StatusClass == string(internal.LinkStatusClassSkipped),
+			StatusCode:  int(row.StatusCode),
+			Error:       row.Error,
+			Latency:     time.Duration(row.LatencyMs) * time.Millisecond,
+		})
+	}
+	return links, nil
+}
+
+// List returns one page of URL records matching the filters, the cursor continues from a previous page
+func (u *URL) List(ctx context.Context, params internal.ListURLsParams) (internal.ListURLsResult, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+
+	createdTo := params.CreatedTo
+	if createdTo.IsZero() {
+		createdTo = maxTime
+	}
+
+	filterLoginForm, haveLoginForm := false, false
+	if params.HaveLoginForm != nil {
+		filterLoginForm, haveLoginForm = true, *params.HaveLoginForm
+	}
+
+	cursorValue, cursorID, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return internal.ListURLsResult{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	var rows []Urls
+
+	switch params.Sort {
+	case internal.URLSortInaccessibleLinksCount:
+		cursorCount := int64(math.MaxInt32)
+		if cursorValue != "" {
+			if cursorCount, err = strconv.ParseInt(cursorValue, 10, 32); err != nil {
+				return internal.ListURLsResult{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+			}
+		}
+
+		rows, err = u.q.ListURLsByInaccessibleLinksCount(ctx, ListURLsByInaccessibleLinksCountParams{
+			Host:            strings.ToLower(params.Host),
+			HtmlVersion:     params.HTMLVersion,
+			FilterLoginForm: filterLoginForm,
+			HaveLoginForm:   haveLoginForm,
+			CreatedFrom:     params.CreatedFrom,
+			CreatedTo:       createdTo,
+			CursorCount:     int32(cursorCount),
+			CursorID:        cursorID,
+			RowLimit:        int32(params.Limit + 1),
+		})
+	default:
+		cursorCreatedAt := maxTime
+		if cursorValue != "" {
+			if cursorCreatedAt, err = time.Parse(time.RFC3339Nano, cursorValue); err != nil {
+				return internal.ListURLsResult{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+			}
+		}
+
+		rows, err = u.q.ListURLsByCreatedAt(ctx, ListURLsByCreatedAtParams{
+			Host:            strings.ToLower(params.Host),
+			HtmlVersion:     params.HTMLVersion,
+			FilterLoginForm: filterLoginForm,
+			HaveLoginForm:   haveLoginForm,
+			CreatedFrom:     params.CreatedFrom,
+			CreatedTo:       createdTo,
+			CursorCreatedAt: cursorCreatedAt,
+			CursorID:        cursorID,
+			RowLimit:        int32(params.Limit + 1),
+		})
+	}
+	if err != nil {
+		return internal.ListURLsResult{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "list URLs")
+	}
+
+	var res internal.ListURLsResult
+
+	if len(rows) > params.Limit {
+		rows = rows[:params.Limit]
+		last := rows[len(rows)-1]
+
+		if params.Sort == internal.URLSortInaccessibleLinksCount {
+			res.NextCursor = encodeCursor(strconv.Itoa(int(last.InaccessibleLinksCount)), last.ID)
+		} else {
+			res.NextCursor = encodeCursor(last.CreatedAt.Format(time.RFC3339Nano), last.ID)
+		}
+	}
+
+	res.URLs = make([]internal.URL, 0, len(rows))
+	for _, row := range rows {
+		URL, err := newURL(row)
+		if err != nil {
+			return internal.ListURLsResult{}, err
+		}
+
+		res.URLs = append(res.URLs, URL)
+	}
+
+	return res, nil
+}
+
+// maxTime is used as upper bound when no date or cursor is given, it is still a valid PostgreSQL timestamp
+var maxTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+// maxUUID sorts after any generated id, used when no cursor is given
+var maxUUID = uuid.Must(uuid.Parse("ffffffff-ffff-ffff-ffff-ffffffffffff"))
+
+func encodeCursor(value string, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value + "," + id.String()))
+}
+
+func decodeCursor(cursor string) (string, uuid.UUID, error) {
+	if cursor == "" {
+		return "", maxUUID, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", uuid.UUID{}, err
+	}
+
+	i := strings.LastIndex(string(raw), ",")
+	if i == -1 {
+		return "", uuid.UUID{}, errors.New("missing separator")
+	}
+
+	id, err := uuid.Parse(string(raw[i+1:]))
+	if err != nil {
+		return "", uuid.UUID{}, err
+	}
+
+	return string(raw[:i]), id, nil
+}
+
+func hostname(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(parsed.Hostname())
+}
+
+func newURL(res Urls) (internal.URL, error) {
+	var headings internal.Headings
+	if err := json.Unmarshal(res.Headings, &headings); err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "unmarshal headings")
+	}
+
+	var redirects []internal.Redirect
+	if err := json.Unmarshal(res.Redirects, &redirects); err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "unmarshal redirects")
+	}
+
+	var doctype internal.Doctype
+	if err := json.Unmarshal(res.Doctype, &doctype); err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "unmarshal doctype")
+	}
+
+	var forms []internal.Form
+	if err := json.Unmarshal(res.Forms, &forms); err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "unmarshal forms")
+	}
+
+	var sections map[string]json.RawMessage
+	if err := json.Unmarshal(res.Sections, &sections); err != nil {
+		return internal.URL{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "unmarshal sections")
+	}
+
 	return internal.URL{
 		ID:                     res.ID.String(),
+		URL:                    res.Url,
+		NormalizedURL:          res.NormalizedUrl,
+		FinalURL:               res.FinalUrl,
+		Redirects:              redirects,
+		StatusCode:             int(res.StatusCode),
+		ContentType:            res.ContentType,
+		ContentLength:          res.ContentLength,
+		FetchDuration:          time.Duration(res.FetchDurationMs) * time.Millisecond,
 		HTMLVersion:            res.HtmlVersion,
+		Doctype:                doctype,
 		PageTitle:              res.PageTitle,
-		HeadingsCount:          res.HeadingsCount,
+		Headings:               headings,
 		LinksCount:             int(res.LinksCount),
 		InaccessibleLinksCount: int(res.InaccessibleLinksCount),
+		InternalLinksCount:     int(res.InternalLinksCount),
+		ExternalLinksCount:     int(res.ExternalLinksCount),
+		NofollowLinksCount:     int(res.NofollowLinksCount),
+		SponsoredLinksCount:    int(res.SponsoredLinksCount),
+		UGCLinksCount:          int(res.UgcLinksCount),
+		UnsafeBlankLinksCount:  int(res.UnsafeBlankLinksCount),
 		HaveLoginForm:          res.HaveLoginForm,
+		Forms:                  forms,
+		Sections:               sections,
+		CreatedAt:              res.CreatedAt,
 	}, nil
 }
@@ -5,6 +5,8 @@ package postgresql
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -23,12 +25,31 @@ func (q *Queries) DeleteURL(ctx context.Context, id uuid.UUID) (uuid.UUID, error
 
 const insertURL = `-- name: InsertURL :one
 INSERT INTO urls (
+  url,
+  normalized_url,
+  host,
+  final_url,
+  redirects,
+  status_code,
+  content_type,
+  content_length,
+  fetch_duration_ms,
   HTML_version,
+  doctype,
   page_title,
-  headings_count,
+  headings,
   links_count,
   inaccessible_links_count,
-  have_login_form
+  internal_links_count,
+  external_links_count,
+  nofollow_links_count,
+  sponsored_links_count,
+  ugc_links_count,
+  unsafe_blank_links_count,
+  have_login_form,
+  forms,
+  sections,
+  created_at
 )
 VALUES (
   $1,
@@ -36,36 +57,313 @@ VALUES (
   $3,
   $4,
   $5,
-  $6
+  $6,
+  $7,
+  $8,
+  $9,
+  $10,
+  $11,
+  $12,
+  $13,
+  $14,
+  $15,
+  $16,
+  $17,
+  $18,
+  $19,
+  $20,
+  $21,
+  $22,
+  $23,
+  $24,
+  $25
 )
-RETURNING id
+RETURNING id, created_at
 `
 
 type InsertURLParams struct {
+	Url                    string
+	Normalizedurl          string
+	Host                   string
+	Finalurl               string
+	Redirects              json.RawMessage
+	Statuscode             int32
+	Contenttype            string
+	Contentlength          int64
+	Fetchdurationms        int64
 	Htmlversion            string
+	Doctype                json.RawMessage
 	Pagetitle              string
-	Headingscount          string
+	Headings               json.RawMessage
 	Linkscount             int32
 	Inaccessiblelinkscount int32
+	Internallinkscount     int32
+	Externallinkscount     int32
+	Nofollowlinkscount     int32
+	Sponsoredlinkscount    int32
+	Ugclinkscount          int32
+	Unsafeblanklinkscount  int32
 	Haveloginform          bool
+	Forms                  json.RawMessage
+	Sections               json.RawMessage
+	Createdat              time.Time
 }
 
-func (q *Queries) InsertURL(ctx context.Context, arg InsertURLParams) (uuid.UUID, error) {
+type InsertURLRow struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+}
+
+func (q *Queries) InsertURL(ctx context.Context, arg InsertURLParams) (InsertURLRow, error) {
 	row := q.db.QueryRowContext(ctx, insertURL,
+		arg.Url,
+		arg.Normalizedurl,
+		arg.Host,
+		arg.Finalurl,
+		arg.Redirects,
+		arg.Statuscode,
+		arg.Contenttype,
+		arg.Contentlength,
+		arg.Fetchdurationms,
 		arg.Htmlversion,
+		arg.Doctype,
 		arg.Pagetitle,
-		arg.Headingscount,
+		arg.Headings,
 		arg.Linkscount,
 		arg.Inaccessiblelinkscount,
+		arg.Internallinkscount,
+		arg.Externallinkscount,
+		arg.Nofollowlinkscount,
+		arg.Sponsoredlinkscount,
+		arg.Ugclinkscount,
+		arg.Unsafeblanklinkscount,
 		arg.Haveloginform,
+		arg.Forms,
+		arg.Sections,
+		arg.Createdat,
+	)
+	var i InsertURLRow
+	err := row.Scan(&i.ID, &i.CreatedAt)
+	return i, err
+}
+
+const listURLsByCreatedAt = `-- name: ListURLsByCreatedAt :many
+SELECT id, html_version, page_title, links_count, inaccessible_links_count, have_login_form, url, final_url, status_code, content_type, content_length, fetch_duration_ms, created_at, host, headings, internal_links_count, external_links_count, nofollow_links_count, sponsored_links_count, ugc_links_count, unsafe_blank_links_count, forms, doctype, redirects, normalized_url, sections FROM urls
+WHERE ($1::varchar = '' OR host = $1)
+  AND ($2::varchar = '' OR html_version = $2)
+  AND (NOT $3::boolean OR have_login_form = $4::boolean)
+  AND created_at >= $5
+  AND created_at < $6
+  AND (created_at, id) < ($7::timestamptz, $8::uuid)
+ORDER BY created_at DESC, id DESC
+LIMIT $9
+`
+
+type ListURLsByCreatedAtParams struct {
+	Host            string
+	HtmlVersion     string
+	FilterLoginForm bool
+	HaveLoginForm   bool
+	CreatedFrom     time.Time
+	CreatedTo       time.Time
+	CursorCreatedAt time.Time
+	CursorID        uuid.UUID
+	RowLimit        int32
+}
+
+func (q *Queries) ListURLsByCreatedAt(ctx context.Context, arg ListURLsByCreatedAtParams) ([]Urls, error) {
+	rows, err := q.db.QueryContext(ctx, listURLsByCreatedAt,
+		arg.Host,
+		arg.HtmlVersion,
+		arg.FilterLoginForm,
+		arg.HaveLoginForm,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Urls{}
+	for rows.Next() {
+		var i Urls
+		if err := rows.Scan(
+			&i.ID,
+			&i.HtmlVersion,
+			&i.PageTitle,
+			&i.LinksCount,
+			&i.InaccessibleLinksCount,
+			&i.HaveLoginForm,
+			&i.Url,
+			&i.FinalUrl,
+			&i.StatusCode,
+			&i.ContentType,
+			&i.ContentLength,
+			&i.FetchDurationMs,
+			&i.CreatedAt,
+			&i.Host,
+			&i.Headings,
+			&i.InternalLinksCount,
+			&i.ExternalLinksCount,
+			&i.NofollowLinksCount,
+			&i.SponsoredLinksCount,
+			&i.UgcLinksCount,
+			&i.UnsafeBlankLinksCount,
+			&i.Forms,
+			&i.Doctype,
+			&i.Redirects,
+			&i.NormalizedUrl,
+			&i.Sections,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listURLsByInaccessibleLinksCount = `-- name: ListURLsByInaccessibleLinksCount :many
+SELECT id, html_version, page_title, links_count, inaccessible_links_count, have_login_form, url, final_url, status_code, content_type, content_length, fetch_duration_ms, created_at, host, headings, internal_links_count, external_links_count, nofollow_links_count, sponsored_links_count, ugc_links_count, unsafe_blank_links_count, forms, doctype, redirects, normalized_url, sections FROM urls
+WHERE ($1::varchar = '' OR host = $1)
+  AND ($2::varchar = '' OR html_version = $2)
+  AND (NOT $3::boolean OR have_login_form = $4::boolean)
+  AND created_at >= $5
+  AND created_at < $6
+  AND (inaccessible_links_count, id) < ($7::integer, $8::uuid)
+ORDER BY inaccessible_links_count DESC, id DESC
+LIMIT $9
+`
+
+type ListURLsByInaccessibleLinksCountParams struct {
+	Host            string
+	HtmlVersion     string
+	FilterLoginForm bool
+	HaveLoginForm   bool
+	CreatedFrom     time.Time
+	CreatedTo       time.Time
+	CursorCount     int32
+	CursorID        uuid.UUID
+	RowLimit        int32
+}
+
+func (q *Queries) ListURLsByInaccessibleLinksCount(ctx context.Context, arg ListURLsByInaccessibleLinksCountParams) ([]Urls, error) {
+	rows, err := q.db.QueryContext(ctx, listURLsByInaccessibleLinksCount,
+		arg.Host,
+		arg.HtmlVersion,
+		arg.FilterLoginForm,
+		arg.HaveLoginForm,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.CursorCount,
+		arg.CursorID,
+		arg.RowLimit,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Urls{}
+	for rows.Next() {
+		var i Urls
+		if err := rows.Scan(
+			&i.ID,
+			&i.HtmlVersion,
+			&i.PageTitle,
+			&i.LinksCount,
+			&i.InaccessibleLinksCount,
+			&i.HaveLoginForm,
+			&i.Url,
+			&i.FinalUrl,
+			&i.StatusCode,
+			&i.ContentType,
+			&i.ContentLength,
+			&i.FetchDurationMs,
+			&i.CreatedAt,
+			&i.Host,
+			&i.Headings,
+			&i.InternalLinksCount,
+			&i.ExternalLinksCount,
+			&i.NofollowLinksCount,
+			&i.SponsoredLinksCount,
+			&i.UgcLinksCount,
+			&i.UnsafeBlankLinksCount,
+			&i.Forms,
+			&i.Doctype,
+			&i.Redirects,
+			&i.NormalizedUrl,
+			&i.Sections,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectLatestURL = `-- name: SelectLatestURL :one
+SELECT id, html_version, page_title, links_count, inaccessible_links_count, have_login_form, url, final_url, status_code, content_type, content_length, fetch_duration_ms, created_at, host, headings, internal_links_count, external_links_count, nofollow_links_count, sponsored_links_count, ugc_links_count, unsafe_blank_links_count, forms, doctype, redirects, normalized_url, sections FROM urls
+WHERE normalized_url = $1
+  AND created_at >= $2
+ORDER BY created_at DESC, id DESC
+LIMIT 1
+`
+
+type SelectLatestURLParams struct {
+	NormalizedUrl string
+	CreatedFrom   time.Time
+}
+
+func (q *Queries) SelectLatestURL(ctx context.Context, arg SelectLatestURLParams) (Urls, error) {
+	row := q.db.QueryRowContext(ctx, selectLatestURL, arg.NormalizedUrl, arg.CreatedFrom)
+	var i Urls
+	err := row.Scan(
+		&i.ID,
+		&i.HtmlVersion,
+		&i.PageTitle,
+		&i.LinksCount,
+		&i.InaccessibleLinksCount,
+		&i.HaveLoginForm,
+		&i.Url,
+		&i.FinalUrl,
+		&i.StatusCode,
+		&i.ContentType,
+		&i.ContentLength,
+		&i.FetchDurationMs,
+		&i.CreatedAt,
+		&i.Host,
+		&i.Headings,
+		&i.InternalLinksCount,
+		&i.ExternalLinksCount,
+		&i.NofollowLinksCount,
+		&i.SponsoredLinksCount,
+		&i.UgcLinksCount,
+		&i.UnsafeBlankLinksCount,
+		&i.Forms,
+		&i.Doctype,
+		&i.Redirects,
+		&i.NormalizedUrl,
+		&i.Sections,
+	)
+	return i, err
 }
 
 const selectURL = `-- name: SelectURL :one
-SELECT id, html_version, page_title, headings_count, links_count, inaccessible_links_count, have_login_form FROM urls
+SELECT id, html_version, page_title, links_count, inaccessible_links_count, have_login_form, url, final_url, status_code, content_type, content_length, fetch_duration_ms, created_at, host, headings, internal_links_count, external_links_count, nofollow_links_count, sponsored_links_count, ugc_links_count, unsafe_blank_links_count, forms, doctype, redirects, normalized_url, sections FROM urls
 WHERE id = $1 LIMIT 1
 `
 
@@ -76,10 +374,142 @@ func (q *Queries) SelectURL(ctx context.Context, id uuid.UUID) (Urls, error) {
 		&i.ID,
 		&i.HtmlVersion,
 		&i.PageTitle,
-		&i.HeadingsCount,
 		&i.LinksCount,
 		&i.InaccessibleLinksCount,
 		&i.HaveLoginForm,
+		&i.Url,
+		&i.FinalUrl,
+		&i.StatusCode,
+		&i.ContentType,
+		&i.ContentLength,
+		&i.FetchDurationMs,
+		&i.CreatedAt,
+		&i.Host,
+		&i.Headings,
+		&i.InternalLinksCount,
+		&i.ExternalLinksCount,
+		&i.NofollowLinksCount,
+		&i.SponsoredLinksCount,
+		&i.UgcLinksCount,
+		&i.UnsafeBlankLinksCount,
+		&i.Forms,
+		&i.Doctype,
+		&i.Redirects,
+		&i.NormalizedUrl,
+		&i.Sections,
 	)
 	return i, err
 }
+
+const selectURLsByNormalizedURL = `-- name: SelectURLsByNormalizedURL :many
+SELECT id, html_version, page_title, links_count, inaccessible_links_count, have_login_form, url, final_url, status_code, content_type, content_length, fetch_duration_ms, created_at, host, headings, internal_links_count, external_links_count, nofollow_links_count, sponsored_links_count, ugc_links_count, unsafe_blank_links_count, forms, doctype, redirects, normalized_url, sections FROM urls
+WHERE normalized_url = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) SelectURLsByNormalizedURL(ctx context.Context, normalizedUrl string) ([]Urls, error) {
+	rows, err := q.db.QueryContext(ctx, selectURLsByNormalizedURL, normalizedUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Urls{}
+	for rows.Next() {
+		var i Urls
+		if err := rows.Scan(
+			&i.ID,
+			&i.HtmlVersion,
+			&i.PageTitle,
+			&i.LinksCount,
+			&i.InaccessibleLinksCount,
+			&i.HaveLoginForm,
+			&i.Url,
+			&i.FinalUrl,
+			&i.StatusCode,
+			&i.ContentType,
+			&i.ContentLength,
+			&i.FetchDurationMs,
+			&i.CreatedAt,
+			&i.Host,
+			&i.Headings,
+			&i.InternalLinksCount,
+			&i.ExternalLinksCount,
+			&i.NofollowLinksCount,
+			&i.SponsoredLinksCount,
+			&i.UgcLinksCount,
+			&i.UnsafeBlankLinksCount,
+			&i.Forms,
+			&i.Doctype,
+			&i.Redirects,
+			&i.NormalizedUrl,
+			&i.Sections,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const selectUnnormalizedURLs = `-- name: SelectUnnormalizedURLs :many
+SELECT id, url FROM urls
+WHERE normalized_url = ''
+  AND id > $1::uuid
+ORDER BY id
+LIMIT $2
+`
+
+type SelectUnnormalizedURLsParams struct {
+	AfterID  uuid.UUID
+	RowLimit int32
+}
+
+type SelectUnnormalizedURLsRow struct {
+	ID  uuid.UUID
+	Url string
+}
+
+func (q *Queries) SelectUnnormalizedURLs(ctx context.Context, arg SelectUnnormalizedURLsParams) ([]SelectUnnormalizedURLsRow, error) {
+	rows, err := q.db.QueryContext(ctx, selectUnnormalizedURLs, arg.AfterID, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SelectUnnormalizedURLsRow{}
+	for rows.Next() {
+		var i SelectUnnormalizedURLsRow
+		if err := rows.Scan(&i.ID, &i.Url); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateURLNormalizedURL = `-- name: UpdateURLNormalizedURL :exec
+UPDATE urls
+SET normalized_url = $1
+WHERE id = $2
+`
+
+type UpdateURLNormalizedURLParams struct {
+	NormalizedUrl string
+	ID            uuid.UUID
+}
+
+func (q *Queries) UpdateURLNormalizedURL(ctx context.Context, arg UpdateURLNormalizedURLParams) error {
+	_, err := q.db.ExecContext(ctx, updateURLNormalizedURL, arg.NormalizedUrl, arg.ID)
+	return err
+}
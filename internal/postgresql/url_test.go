@@ -29,7 +29,24 @@ func TestURL_Create(t *testing.T) {
 	t.Run("Create: OK", func(t *testing.T) {
 		t.Parallel()
 
-		URLr, err := postgresql.NewURL(newDB(t)).Create(context.Background(), "22", "asd", "asd", 3, 2, true)
+		fetchedAt := time.Now().Add(-30 * time.Second).Truncate(time.Microsecond)
+
+		URLr, err := postgresql.NewURL(newDB(t)).Create(context.Background(), internal.URL{
+			URL:                    "https://example.com",
+			NormalizedURL:          "https://example.com/",
+			FinalURL:               "https://www.example.com/",
+			StatusCode:             200,
+			ContentType:            "text/html",
+			ContentLength:          512,
+			FetchDuration:          120 * time.Millisecond,
+			HTMLVersion:            "22",
+			PageTitle:              "asd",
+			Headings:               internal.Headings{H1: 1, Outline: []internal.Heading{{Level: 1, Text: "asd"}}},
+			LinksCount:             3,
+			InaccessibleLinksCount: 2,
+			HaveLoginForm:          true,
+			CreatedAt:              fetchedAt,
+		}, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %s", err)
 		}
@@ -37,6 +54,10 @@ func TestURL_Create(t *testing.T) {
 		if URLr.ID == "" {
 			t.Fatalf("expected valid record, got empty value")
 		}
+
+		if !URLr.CreatedAt.Equal(fetchedAt) {
+			t.Fatalf("expected the fetch time %s to be stored, got %s", fetchedAt, URLr.CreatedAt)
+		}
 	})
 }
 
@@ -48,7 +69,20 @@ func TestURL_Delete(t *testing.T) {
 
 		store := postgresql.NewURL(newDB(t))
 
-		createdURL, err := store.Create(context.Background(), "22", "asd", "asd", 2, 1, true)
+		createdURL, err := store.Create(context.Background(), internal.URL{
+			URL:                    "https://example.com",
+			FinalURL:               "https://www.example.com/",
+			StatusCode:             200,
+			ContentType:            "text/html",
+			ContentLength:          512,
+			FetchDuration:          120 * time.Millisecond,
+			HTMLVersion:            "22",
+			PageTitle:              "asd",
+			Headings:               internal.Headings{H1: 1, Outline: []internal.Heading{{Level: 1, Text: "asd"}}},
+			LinksCount:             2,
+			InaccessibleLinksCount: 1,
+			HaveLoginForm:          true,
+		}, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %s", err)
 		}
@@ -82,7 +116,23 @@ func TestURL_Find(t *testing.T) {
 
 		store := postgresql.NewURL(newDB(t))
 
-		originalURL, err := store.Create(context.Background(), "22", "asd", "asd", 1, 1, true)
+		originalURL, err := store.Create(context.Background(), internal.URL{
+			URL:                    "https://example.com",
+			FinalURL:               "https://www.example.com/",
+			StatusCode:             200,
+			ContentType:            "text/html",
+			ContentLength:          512,
+			FetchDuration:          120 * time.Millisecond,
+			HTMLVersion:            "22",
+			PageTitle:              "asd",
+			Headings:               internal.Headings{H1: 1, Outline: []internal.Heading{{Level: 1, Text: "asd"}}},
+			LinksCount:             1,
+			InaccessibleLinksCount: 1,
+			InternalLinksCount:     1,
+			NofollowLinksCount:     1,
+			UnsafeBlankLinksCount:  1,
+			HaveLoginForm:          true,
+		}, nil)
 		if err != nil {
 			t.Fatalf("expected no error, got %s", err)
 		}
@@ -126,6 +176,272 @@ func TestURL_Find(t *testing.T) {
 	})
 }
 
+func TestURL_FindLinks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("FindLinks: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewURL(newDB(t))
+
+		links := []internal.Link{
+			{Href: "/about", ResolvedURL: "https://example.com/about", Text: "About", StatusCode: 200, Latency: 12 * time.Millisecond},
+			{Href: "https://other.com/", ResolvedURL: "https://other.com/", Text: "Other", External: true, StatusCode: 404, Latency: 30 * time.Millisecond},
+			{Href: "mailto:info@example.com", Text: "Mail", Skipped: true},
+		}
+
+		createdURL, err := store.Create(context.Background(), internal.URL{
+			URL:                    "https://example.com",
+			FinalURL:               "https://example.com/",
+			StatusCode:             200,
+			HTMLVersion:            "HTML 5",
+			PageTitle:              "asd",
+			Headings:               internal.Headings{Outline: []internal.Heading{}},
+			LinksCount:             3,
+			InaccessibleLinksCount: 1,
+		}, links)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actual, err := store.FindLinks(context.Background(), createdURL.ID, "")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(links, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(links, actual))
+		}
+
+		actual, err = store.FindLinks(context.Background(), createdURL.ID, internal.LinkStatusClass4xx)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(links[1:2], actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(links[1:2], actual))
+		}
+	})
+}
+
+func TestURL_FindLatest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("FindLatest: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewURL(newDB(t))
+
+		createdURL, err := store.Create(context.Background(), internal.URL{
+			URL:                    "https://example.com/latest",
+			HTMLVersion:            "HTML 5",
+			PageTitle:              "asd",
+			Headings:               internal.Headings{Outline: []internal.Heading{}},
+			LinksCount:             2,
+			InaccessibleLinksCount: 1,
+		}, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actual, err := store.FindLatest(context.Background(), createdURL.NormalizedURL, createdURL.CreatedAt.Add(-time.Minute))
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actual.ID != createdURL.ID {
+			t.Fatalf("expected %s, got %s", createdURL.ID, actual.ID)
+		}
+	})
+
+	t.Run("FindLatest: ERR expired", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewURL(newDB(t))
+
+		createdURL, err := store.Create(context.Background(), internal.URL{
+			URL:                    "https://example.com/expired",
+			HTMLVersion:            "HTML 5",
+			PageTitle:              "asd",
+			Headings:               internal.Headings{Outline: []internal.Heading{}},
+			LinksCount:             2,
+			InaccessibleLinksCount: 1,
+		}, nil)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err = store.FindLatest(context.Background(), createdURL.NormalizedURL, createdURL.CreatedAt.Add(time.Minute))
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
+}
+
+func TestURL_History(t *testing.T) {
+	t.Parallel()
+
+	t.Run("History: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewURL(newDB(t))
+
+		var expected []internal.URL
+
+		for _, title := range []string{"first", "second"} {
+			createdURL, err := store.Create(context.Background(), internal.URL{
+				URL:                    "https://example.com/history",
+				NormalizedURL:          "https://example.com/history",
+				HTMLVersion:            "HTML 5",
+				PageTitle:              title,
+				Headings:               internal.Headings{Outline: []internal.Heading{}},
+				LinksCount:             2,
+				InaccessibleLinksCount: 1,
+			}, nil)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			expected = append(expected, createdURL)
+		}
+
+		actual, err := store.History(context.Background(), "https://example.com/history")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+}
+
+func TestURL_UpdateNormalizedURL(t *testing.T) {
+	t.Parallel()
+
+	t.Run("UpdateNormalizedURL: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewURL(newDB(t))
+
+		var created []internal.URL
+
+		for _, normalizedURL := range []string{"", "https://example.com/normalized"} {
+			createdURL, err := store.Create(context.Background(), internal.URL{
+				URL:           "https://Example.com/normalized",
+				NormalizedURL: normalizedURL,
+				HTMLVersion:   "HTML 5",
+				PageTitle:     "asd",
+				Headings:      internal.Headings{Outline: []internal.Heading{}},
+			}, nil)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			created = append(created, createdURL)
+		}
+
+		actual, err := store.ListUnnormalized(context.Background(), "", 10)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := []internal.URL{{ID: created[0].ID, URL: "https://Example.com/normalized"}}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+
+		if err := store.UpdateNormalizedURL(context.Background(), created[0].ID, "https://example.com/normalized"); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actual, err = store.ListUnnormalized(context.Background(), "", 10)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(actual) != 0 {
+			t.Fatalf("expected no unnormalized records, got %+v", actual)
+		}
+
+		history, err := store.History(context.Background(), "https://example.com/normalized")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(history) != 2 {
+			t.Fatalf("expected both records, got %d", len(history))
+		}
+	})
+}
+
+func TestURL_List(t *testing.T) {
+	t.Parallel()
+
+	t.Run("List: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewURL(newDB(t))
+
+		for i, URL := range []string{"https://example.com/a", "https://Example.com/b", "https://other.com/"} {
+			if _, err := store.Create(context.Background(), internal.URL{
+				URL:                    URL,
+				HTMLVersion:            "HTML 5",
+				PageTitle:              "asd",
+				Headings:               internal.Headings{H1: 1, Outline: []internal.Heading{{Level: 1, Text: "asd"}}},
+				InaccessibleLinksCount: i,
+			}, nil); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		first, err := store.List(context.Background(), internal.ListURLsParams{
+			Host:  "example.com",
+			Sort:  internal.URLSortInaccessibleLinksCount,
+			Limit: 1,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(first.URLs) != 1 || first.URLs[0].URL != "https://Example.com/b" || first.NextCursor == "" {
+			t.Fatalf("unexpected first page: %+v", first)
+		}
+
+		second, err := store.List(context.Background(), internal.ListURLsParams{
+			Host:   "example.com",
+			Sort:   internal.URLSortInaccessibleLinksCount,
+			Cursor: first.NextCursor,
+			Limit:  1,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(second.URLs) != 1 || second.URLs[0].URL != "https://example.com/a" || second.NextCursor != "" {
+			t.Fatalf("unexpected second page: %+v", second)
+		}
+	})
+
+	t.Run("List: ERR cursor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := postgresql.NewURL(newDB(t)).List(context.Background(), internal.ListURLsParams{
+			Sort:   internal.URLSortCreatedAt,
+			Cursor: "x",
+			Limit:  1,
+		})
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
+
 func newDB(tb testing.TB) *sql.DB {
 	dsn := &url.URL{
 		Scheme: "postgres",
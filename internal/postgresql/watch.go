@@ -0,0 +1,228 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const (
+	// watchesLockID identifies the advisory lock held by the replica triggering the due watches
+	watchesLockID = 0x75726c77
+	// maxDueWatches is the maximum number of watches triggered at once
+	maxDueWatches = 100
+)
+
+// Watch represents the repository used for interacting with Watch records
+type Watch struct {
+	db *sql.DB
+	q  *Queries
+}
+
+// NewWatch instantiates the Watch repository
+func NewWatch(db *sql.DB) *Watch {
+	return &Watch{
+		db: db,
+		q:  New(db),
+	}
+}
+
+// Create inserts a new Watch record
+func (w *Watch) Create(ctx context.Context, watch internal.Watch) (internal.Watch, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res, err := w.q.InsertWatch(ctx, InsertWatchParams{
+		Url:       watch.URL,
+		Schedule:  watch.Schedule,
+		NextRunAt: watch.NextRunAt,
+	})
+	if err != nil {
+		return internal.Watch{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert watch")
+	}
+	return newWatch(SelectWatchRow{
+		ID:        res.ID,
+		Url:       res.Url,
+		Schedule:  res.Schedule,
+		NextRunAt: res.NextRunAt,
+		LastRunAt: res.LastRunAt,
+		LastJobID: res.LastJobID,
+		CreatedAt: res.CreatedAt,
+		UpdatedAt: res.UpdatedAt,
+	}), nil
+}
+
+// Delete deletes the existing record matching the id, the jobs it enqueued are kept
+func (w *Watch) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	_, err = w.q.DeleteWatch(ctx, val)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "watch not found")
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "delete watch")
+	}
+	return nil
+}
+
+// Find returns the requested Watch by searching its id
+func (w *Watch) Find(ctx context.Context, id string) (internal.Watch, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Watch{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	res, err := w.q.SelectWatch(ctx, val)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Watch{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "watch not found")
+		}
+
+		return internal.Watch{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select watch")
+	}
+	return newWatch(res), nil
+}
+
+// List returns all the Watch records, oldest first
+func (w *Watch) List(ctx context.Context) ([]internal.Watch, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	rows, err := w.q.ListWatches(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "list watches")
+	}
+
+	watches := make([]internal.Watch, 0, len(rows))
+	for _, row := range rows {
+		watches = append(watches, newWatch(SelectWatchRow(row)))
+	}
+
+	return watches, nil
+}
+
+// Trigger enqueues a job for every watch due at now and moves each of them to the run returned by next,
+// watches next fails for get no job and are postponed to retryAt. Only one replica triggers watches at a
+// time, the others get no watch until the lock is released.
+func (w *Watch) Trigger(ctx context.Context, now, retryAt time.Time, next func(internal.Watch) (time.Time, error)) ([]internal.Watch, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.Trigger")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+
+	tx, err := w.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "begin transaction")
+	}
+	defer tx.Rollback()
+
+	q := w.q.WithTx(tx)
+
+	locked, err := q.TryLockWatches(ctx, watchesLockID)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "lock watches")
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	rows, err := q.SelectDueWatches(ctx, SelectDueWatchesParams{
+		Now:      now,
+		RowLimit: maxDueWatches,
+	})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select due watches")
+	}
+
+	watches := make([]internal.Watch, 0, len(rows))
+	for _, row := range rows {
+		watch := newWatch(SelectWatchRow{
+			ID:        row.ID,
+			Url:       row.Url,
+			Schedule:  row.Schedule,
+			LastRunAt: sql.NullTime{Time: now, Valid: true},
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+
+		// a broken schedule must not hold back the other watches, next reports the failure and the watch
+		// leaves the head of the due ones
+		watch.NextRunAt, err = next(watch)
+		if err != nil {
+			span.RecordError(err)
+
+			if err := q.PostponeWatch(ctx, PostponeWatchParams{
+				NextRunAt: retryAt,
+				ID:        row.ID,
+			}); err != nil {
+				return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "postpone watch")
+			}
+
+			continue
+		}
+
+		job, err := q.InsertWatchJob(ctx, InsertWatchJobParams{
+			Url:     row.Url,
+			WatchID: row.ID,
+		})
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert watch job")
+		}
+
+		watch.LastJobID = job.ID.String()
+		watch.LastStatus = internal.JobStatus(job.Status)
+
+		if err := q.UpdateWatchRun(ctx, UpdateWatchRunParams{
+			NextRunAt: watch.NextRunAt,
+			LastRunAt: sql.NullTime{Time: now, Valid: true},
+			LastJobID: job.ID,
+			ID:        row.ID,
+		}); err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "update watch run")
+		}
+
+		watches = append(watches, watch)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "commit transaction")
+	}
+
+	return watches, nil
+}
+
+func newWatch(res SelectWatchRow) internal.Watch {
+	var lastJobID string
+	if res.LastJobID != uuid.Nil {
+		lastJobID = res.LastJobID.String()
+	}
+
+	return internal.Watch{
+		ID:         res.ID.String(),
+		URL:        res.Url,
+		Schedule:   res.Schedule,
+		NextRunAt:  res.NextRunAt,
+		LastRunAt:  res.LastRunAt.Time,
+		LastJobID:  lastJobID,
+		LastStatus: internal.JobStatus(res.LastStatus),
+		LastURLID:  res.LastUrlID,
+		LastError:  res.LastError,
+		CreatedAt:  res.CreatedAt,
+		UpdatedAt:  res.UpdatedAt,
+	}
+}
@@ -0,0 +1,167 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/postgresql"
+)
+
+func TestWatch_Trigger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Trigger: OK", func(t *testing.T) {
+		t.Parallel()
+
+		db := newDB(t)
+		store := postgresql.NewWatch(db)
+		now := time.Now()
+
+		createdWatch, err := store.Create(context.Background(), internal.Watch{
+			URL:       "https://example.com",
+			Schedule:  "@every 1h",
+			NextRunAt: now.Add(-time.Minute),
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if _, err := store.Create(context.Background(), internal.Watch{
+			URL:       "https://example.com/later",
+			Schedule:  "@every 1h",
+			NextRunAt: now.Add(time.Hour),
+		}); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		next := now.Add(time.Hour).Truncate(time.Microsecond)
+
+		triggered, err := store.Trigger(context.Background(), now, now.Add(time.Minute), func(internal.Watch) (time.Time, error) {
+			return next, nil
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(triggered) != 1 || triggered[0].ID != createdWatch.ID {
+			t.Fatalf("expected watch %s to be triggered, got %+v", createdWatch.ID, triggered)
+		}
+
+		actualWatch, err := store.Find(context.Background(), createdWatch.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !actualWatch.NextRunAt.Equal(next) || actualWatch.LastJobID == "" || actualWatch.LastStatus != internal.JobStatusQueued {
+			t.Fatalf("expected watch to be moved to its next run, got %+v", actualWatch)
+		}
+
+		job, err := postgresql.NewJob(db).Find(context.Background(), actualWatch.LastJobID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if job.WatchID != createdWatch.ID || job.URL != createdWatch.URL {
+			t.Fatalf("expected job of watch %s, got %+v", createdWatch.ID, job)
+		}
+
+		triggered, err = store.Trigger(context.Background(), now, now.Add(time.Minute), func(internal.Watch) (time.Time, error) {
+			return next, nil
+		})
+		if err != nil || len(triggered) != 0 {
+			t.Fatalf("expected no watch to be triggered again, got %+v, %v", triggered, err)
+		}
+	})
+
+	t.Run("Trigger: OK next fails", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewWatch(newDB(t))
+		now := time.Now()
+
+		var created []internal.Watch
+
+		for _, schedule := range []string{"broken", "@every 1h"} {
+			createdWatch, err := store.Create(context.Background(), internal.Watch{
+				URL:       "https://example.com",
+				Schedule:  schedule,
+				NextRunAt: now.Add(-time.Minute),
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			created = append(created, createdWatch)
+		}
+
+		retryAt := now.Add(time.Minute).Truncate(time.Microsecond)
+
+		triggered, err := store.Trigger(context.Background(), now, retryAt, func(watch internal.Watch) (time.Time, error) {
+			if watch.Schedule == "broken" {
+				return time.Time{}, errors.New("invalid schedule")
+			}
+
+			return now.Add(time.Hour), nil
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(triggered) != 1 || triggered[0].ID != created[1].ID || triggered[0].LastJobID == "" {
+			t.Fatalf("expected watch %s to be triggered, got %+v", created[1].ID, triggered)
+		}
+
+		skippedWatch, err := store.Find(context.Background(), created[0].ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if skippedWatch.LastJobID != "" || !skippedWatch.NextRunAt.Equal(retryAt) {
+			t.Fatalf("expected watch to be postponed without a job, got %+v", skippedWatch)
+		}
+	})
+}
+
+func TestWatch_Find(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Find: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewWatch(newDB(t))
+
+		createdWatch, err := store.Create(context.Background(), internal.Watch{
+			URL:       "https://example.com",
+			Schedule:  "0 * * * *",
+			NextRunAt: time.Date(2021, 7, 2, 11, 0, 0, 0, time.UTC),
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actualWatch, err := store.Find(context.Background(), createdWatch.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(createdWatch, actualWatch) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(createdWatch, actualWatch))
+		}
+
+		if err := store.Delete(context.Background(), createdWatch.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err = store.Find(context.Background(), createdWatch.ID)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
@@ -0,0 +1,244 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// maxWebhookDeliveries is the maximum number of deliveries returned for one webhook
+const maxWebhookDeliveries = 100
+
+// Webhook represents the repository used for interacting with Webhook and WebhookDelivery records
+type Webhook struct {
+	q *Queries
+}
+
+// NewWebhook instantiates the Webhook repository
+func NewWebhook(db *sql.DB) *Webhook {
+	return &Webhook{
+		q: New(db),
+	}
+}
+
+// Create inserts a new Webhook record
+func (w *Webhook) Create(ctx context.Context, webhook internal.Webhook) (internal.Webhook, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	events, err := json.Marshal(webhook.Events)
+	if err != nil {
+		return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "marshal events")
+	}
+	res, err := w.q.InsertWebhook(ctx, InsertWebhookParams{
+		Url:    webhook.URL,
+		Events: events,
+		Secret: webhook.Secret,
+	})
+	if err != nil {
+		return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert webhook")
+	}
+	return newWebhook(res)
+}
+
+// Delete deletes the existing record matching the id along with its deliveries
+func (w *Webhook) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	_, err = w.q.DeleteWebhook(ctx, val)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "webhook not found")
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "delete webhook")
+	}
+	return nil
+}
+
+// Find returns the requested Webhook by searching its id
+func (w *Webhook) Find(ctx context.Context, id string) (internal.Webhook, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	res, err := w.q.SelectWebhook(ctx, val)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "webhook not found")
+		}
+
+		return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select webhook")
+	}
+	return newWebhook(res)
+}
+
+// List returns all the Webhook records, oldest first
+func (w *Webhook) List(ctx context.Context) ([]internal.Webhook, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	rows, err := w.q.ListWebhooks(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "list webhooks")
+	}
+
+	webhooks := make([]internal.Webhook, 0, len(rows))
+	for _, row := range rows {
+		webhook, err := newWebhook(row)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+// Enqueue inserts a pending delivery of the payload for every webhook subscribed to the event
+func (w *Webhook) Enqueue(ctx context.Context, event internal.WebhookEvent, payload []byte) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Enqueue")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	if err := w.q.InsertWebhookDeliveries(ctx, InsertWebhookDeliveriesParams{
+		Event:   string(event),
+		Payload: payload,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert webhook deliveries")
+	}
+	return nil
+}
+
+// ClaimDelivery returns the oldest pending delivery due at now along with its webhook, the delivery is
+// not claimed again before leaseUntil. Concurrent callers never claim the same record.
+func (w *Webhook) ClaimDelivery(ctx context.Context, now, leaseUntil time.Time) (internal.WebhookDelivery, internal.Webhook, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.ClaimDelivery")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	res, err := w.q.ClaimWebhookDelivery(ctx, ClaimWebhookDeliveryParams{
+		LeaseUntil: leaseUntil,
+		Now:        now,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.WebhookDelivery{}, internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "no webhook delivery pending")
+		}
+
+		return internal.WebhookDelivery{}, internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "claim webhook delivery")
+	}
+
+	delivery := newWebhookDelivery(WebhookDeliveries{
+		ID:             res.ID,
+		WebhookID:      res.WebhookID,
+		Event:          res.Event,
+		Payload:        res.Payload,
+		Status:         res.Status,
+		Attempts:       res.Attempts,
+		NextAttemptAt:  res.NextAttemptAt,
+		ResponseStatus: res.ResponseStatus,
+		Error:          res.Error,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	})
+
+	webhook := internal.Webhook{
+		ID:     res.WebhookID.String(),
+		URL:    res.WebhookUrl,
+		Secret: res.WebhookSecret,
+	}
+
+	return delivery, webhook, nil
+}
+
+// UpdateDelivery records the outcome of the latest attempt of the delivery
+func (w *Webhook) UpdateDelivery(ctx context.Context, delivery internal.WebhookDelivery) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.UpdateDelivery")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(delivery.ID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	if err := w.q.UpdateWebhookDelivery(ctx, UpdateWebhookDeliveryParams{
+		Status:         string(delivery.Status),
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: int32(delivery.ResponseStatus),
+		Error:          delivery.Error,
+		ID:             val,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "update webhook delivery")
+	}
+	return nil
+}
+
+// FindDeliveries returns the latest deliveries of the webhook, newest first
+func (w *Webhook) FindDeliveries(ctx context.Context, id string) ([]internal.WebhookDelivery, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.FindDeliveries")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+	defer span.End()
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+	rows, err := w.q.SelectWebhookDeliveries(ctx, SelectWebhookDeliveriesParams{
+		WebhookID: val,
+		RowLimit:  maxWebhookDeliveries,
+	})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select webhook deliveries")
+	}
+
+	deliveries := make([]internal.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, newWebhookDelivery(row))
+	}
+
+	return deliveries, nil
+}
+
+func newWebhook(res Webhooks) (internal.Webhook, error) {
+	var events []internal.WebhookEvent
+	if err := json.Unmarshal(res.Events, &events); err != nil {
+		return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "unmarshal events")
+	}
+
+	return internal.Webhook{
+		ID:        res.ID.String(),
+		URL:       res.Url,
+		Events:    events,
+		Secret:    res.Secret,
+		CreatedAt: res.CreatedAt,
+	}, nil
+}
+
+func newWebhookDelivery(res WebhookDeliveries) internal.WebhookDelivery {
+	return internal.WebhookDelivery{
+		ID:             res.ID.String(),
+		WebhookID:      res.WebhookID.String(),
+		Event:          internal.WebhookEvent(res.Event),
+		Payload:        res.Payload,
+		Status:         internal.WebhookDeliveryStatus(res.Status),
+		Attempts:       int(res.Attempts),
+		NextAttemptAt:  res.NextAttemptAt,
+		ResponseStatus: int(res.ResponseStatus),
+		Error:          res.Error,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}
+}
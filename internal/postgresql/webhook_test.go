@@ -0,0 +1,87 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/postgresql"
+)
+
+func TestWebhook_ClaimDelivery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ClaimDelivery: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewWebhook(newDB(t))
+
+		subscribed, err := store.Create(context.Background(), internal.Webhook{
+			URL:    "https://hooks.example.com/subscribed",
+			Events: []internal.WebhookEvent{internal.WebhookEventTitleChanged},
+			Secret: "s3cr3t",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		other, err := store.Create(context.Background(), internal.Webhook{
+			URL:    "https://hooks.example.com/other",
+			Events: []internal.WebhookEvent{internal.WebhookEventFetchFailed},
+			Secret: "s3cr3t",
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := store.Enqueue(context.Background(), internal.WebhookEventTitleChanged, []byte(`{"event":"title.changed"}`)); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		now := time.Now()
+
+		delivery, webhook, err := store.ClaimDelivery(context.Background(), now, now.Add(time.Minute))
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if webhook.ID != subscribed.ID || webhook.Secret != "s3cr3t" || delivery.Attempts != 1 {
+			t.Fatalf("expected first attempt of a delivery to %s, got %+v %+v", subscribed.ID, delivery, webhook)
+		}
+
+		// leased until the attempt is recorded
+		_, _, err = store.ClaimDelivery(context.Background(), now, now.Add(time.Minute))
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected not found error, got %s", err)
+		}
+
+		delivery.Status = internal.WebhookDeliveryStatusSucceeded
+		delivery.ResponseStatus = 204
+
+		if err := store.UpdateDelivery(context.Background(), delivery); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		deliveries, err := store.FindDeliveries(context.Background(), subscribed.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(deliveries) != 1 || deliveries[0].Status != internal.WebhookDeliveryStatusSucceeded {
+			t.Fatalf("expected one succeeded delivery, got %+v", deliveries)
+		}
+
+		deliveries, err = store.FindDeliveries(context.Background(), other.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(deliveries) != 0 {
+			t.Fatalf("expected no delivery to the webhook not subscribed, got %+v", deliveries)
+		}
+	})
+}
@@ -0,0 +1,7 @@
+package internal
+
+// Redirect is one hop of the redirect chain followed while fetching a page
+type Redirect struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"statusCode"`
+}
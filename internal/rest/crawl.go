@@ -0,0 +1,185 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+//go:generate counterfeiter -o resttesting/crawl_service.gen.go . CrawlService
+
+// CrawlService
+type CrawlService interface {
+	Create(ctx context.Context, crawl internal.Crawl) (internal.Crawl, error)
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.Crawl, error)
+}
+
+// CrawlHandler
+type CrawlHandler struct {
+	svc CrawlService
+}
+
+// NewCrawlHandler
+func NewCrawlHandler(svc CrawlService) *CrawlHandler {
+	return &CrawlHandler{
+		svc: svc,
+	}
+}
+
+// Register connects the handlers to the router.
+func (h *CrawlHandler) Register(r *mux.Router) {
+	r.HandleFunc("/crawls", h.create).Methods(http.MethodPost)
+	r.HandleFunc(fmt.Sprintf("/crawls/{id:%s}", uuidRegEx), h.find).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/crawls/{id:%s}", uuidRegEx), h.delete).Methods(http.MethodDelete)
+}
+
+// Crawl analyzes the pages of a site starting from the seed URL, Summary aggregates the pages crawled so far.
+type Crawl struct {
+	ID        string       `json:"id"`
+	URL       string       `json:"url"`
+	Scope     string       `json:"scope"`
+	MaxDepth  int          `json:"maxDepth"`
+	MaxPages  int          `json:"maxPages"`
+	Status    string       `json:"status"`
+	Summary   CrawlSummary `json:"summary"`
+	Pages     []CrawlPage  `json:"pages"`
+	Error     string       `json:"error,omitempty"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
+}
+
+// CrawlSummary is the aggregate of the pages of a crawl, failed pages are only counted by PagesFailed.
+type CrawlSummary struct {
+	PagesCrawled            int `json:"pagesCrawled"`
+	PagesFailed             int `json:"pagesFailed"`
+	BrokenLinksCount        int `json:"brokenLinksCount"`
+	PagesWithoutTitleCount  int `json:"pagesWithoutTitleCount"`
+	PagesWithLoginFormCount int `json:"pagesWithLoginFormCount"`
+}
+
+// CrawlPage is one page reached by a crawl, URLID refers to its analysis unless it failed.
+type CrawlPage struct {
+	URL                    string `json:"url"`
+	Depth                  int    `json:"depth"`
+	URLID                  string `json:"URLId,omitempty"`
+	PageTitle              string `json:"pageTitle"`
+	InaccessibleLinksCount int    `json:"inaccessibleLinksCount"`
+	HaveLoginForm          bool   `json:"haveLoginForm"`
+	Error                  string `json:"error,omitempty"`
+}
+
+func newCrawl(crawl internal.Crawl) Crawl {
+	summary := crawl.Summary()
+
+	res := Crawl{
+		ID:       crawl.ID,
+		URL:      crawl.URL,
+		Scope:    string(crawl.Scope),
+		MaxDepth: crawl.MaxDepth,
+		MaxPages: crawl.MaxPages,
+		Status:   string(crawl.Status),
+		Summary: CrawlSummary{
+			PagesCrawled:            summary.PagesCrawled,
+			PagesFailed:             summary.PagesFailed,
+			BrokenLinksCount:        summary.BrokenLinksCount,
+			PagesWithoutTitleCount:  summary.PagesWithoutTitleCount,
+			PagesWithLoginFormCount: summary.PagesWithLoginFormCount,
+		},
+		Pages:     make([]CrawlPage, 0, len(crawl.Pages)),
+		Error:     crawl.Error,
+		CreatedAt: crawl.CreatedAt,
+		UpdatedAt: crawl.UpdatedAt,
+	}
+
+	for _, page := range crawl.Pages {
+		res.Pages = append(res.Pages, CrawlPage{
+			URL:                    page.URL,
+			Depth:                  page.Depth,
+			URLID:                  page.URLID,
+			PageTitle:              page.PageTitle,
+			InaccessibleLinksCount: page.InaccessibleLinksCount,
+			HaveLoginForm:          page.HaveLoginForm,
+			Error:                  page.Error,
+		})
+	}
+
+	return res
+}
+
+// CreateCrawlsRequest defines the request used for creating crawls, Scope is either host or pathPrefix and
+// zero values use the defaults.
+type CreateCrawlsRequest struct {
+	URL      string `json:"url"`
+	Scope    string `json:"scope"`
+	MaxDepth int    `json:"maxDepth"`
+	MaxPages int    `json:"maxPages"`
+}
+
+// CrawlResponse defines the response returned back after creating or searching one crawl.
+type CrawlResponse struct {
+	Crawl Crawl `json:"crawl"`
+}
+
+func (h *CrawlHandler) create(w http.ResponseWriter, r *http.Request) {
+	var req CreateCrawlsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request",
+			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json decoder"))
+		return
+	}
+
+	defer r.Body.Close()
+
+	crawl, err := h.svc.Create(r.Context(), internal.Crawl{
+		URL:      req.URL,
+		Scope:    internal.CrawlScope(req.Scope),
+		MaxDepth: req.MaxDepth,
+		MaxPages: req.MaxPages,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create failed", err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/crawls/%s", crawl.ID))
+
+	renderResponse(w,
+		&CrawlResponse{
+			Crawl: newCrawl(crawl),
+		},
+		http.StatusAccepted)
+}
+
+func (h *CrawlHandler) delete(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	if err := h.svc.Delete(r.Context(), id); err != nil {
+		renderErrorResponse(r.Context(), w, "delete failed", err)
+		return
+	}
+
+	renderResponse(w, struct{}{}, http.StatusOK)
+}
+
+func (h *CrawlHandler) find(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	crawl, err := h.svc.Find(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find failed", err)
+		return
+	}
+
+	renderResponse(w,
+		&CrawlResponse{
+			Crawl: newCrawl(crawl),
+		},
+		http.StatusOK)
+}
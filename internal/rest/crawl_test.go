@@ -0,0 +1,264 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/rest"
+	"github.com/Oguzyildirim/url-info/internal/rest/resttesting"
+)
+
+func TestCrawls_Create(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeCrawlService)
+		input  []byte
+		output output
+	}{
+		{
+			"OK: 202",
+			func(s *resttesting.FakeCrawlService) {
+				s.CreateReturns(
+					internal.Crawl{
+						ID:        "a-b-c",
+						URL:       "https://example.com",
+						Scope:     internal.CrawlScopeHost,
+						MaxDepth:  2,
+						MaxPages:  50,
+						Status:    internal.JobStatusQueued,
+						Pages:     []internal.CrawlPage{},
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+					nil)
+			},
+			func() []byte {
+				b, _ := json.Marshal(&rest.CreateCrawlsRequest{
+					URL: "https://example.com",
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusAccepted,
+				&rest.CrawlResponse{
+					Crawl: rest.Crawl{
+						ID:        "a-b-c",
+						URL:       "https://example.com",
+						Scope:     "host",
+						MaxDepth:  2,
+						MaxPages:  50,
+						Status:    "queued",
+						Pages:     []rest.CrawlPage{},
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				&rest.CrawlResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(*resttesting.FakeCrawlService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "json decoder: unexpected EOF",
+					Error:  "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 scope",
+			func(s *resttesting.FakeCrawlService) {
+				s.CreateReturns(internal.Crawl{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, `unsupported scope "domain"`))
+			},
+			[]byte(`{"url":"https://example.com","scope":"domain"}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: `unsupported scope "domain"`,
+					Error:  "create failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeCrawlService{}
+			tt.setup(svc)
+
+			rest.NewCrawlHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/crawls", bytes.NewReader(tt.input)))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if res.StatusCode == http.StatusAccepted && res.Header.Get("Location") != "/crawls/a-b-c" {
+				t.Fatalf("expected location of the crawl, got %q", res.Header.Get("Location"))
+			}
+		})
+	}
+}
+
+func TestCrawls_Find(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeCrawlService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeCrawlService) {
+				s.FindReturns(
+					internal.Crawl{
+						ID:       "a-b-c",
+						URL:      "https://example.com/docs/",
+						Scope:    internal.CrawlScopePathPrefix,
+						MaxDepth: 2,
+						MaxPages: 50,
+						Status:   internal.JobStatusSucceeded,
+						Pages: []internal.CrawlPage{
+							{URL: "https://example.com/docs/", URLID: "d-e-f", PageTitle: "Docs", InaccessibleLinksCount: 2},
+							{URL: "https://example.com/docs/login", Depth: 1, URLID: "g-h-i", HaveLoginForm: true},
+							{URL: "https://example.com/docs/guide.pdf", Depth: 1, Error: "unsupported content type"},
+						},
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 1, 0, 0, time.UTC),
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.CrawlResponse{
+					Crawl: rest.Crawl{
+						ID:       "a-b-c",
+						URL:      "https://example.com/docs/",
+						Scope:    "pathPrefix",
+						MaxDepth: 2,
+						MaxPages: 50,
+						Status:   "succeeded",
+						Summary: rest.CrawlSummary{
+							PagesCrawled:            2,
+							PagesFailed:             1,
+							BrokenLinksCount:        2,
+							PagesWithoutTitleCount:  1,
+							PagesWithLoginFormCount: 1,
+						},
+						Pages: []rest.CrawlPage{
+							{URL: "https://example.com/docs/", URLID: "d-e-f", PageTitle: "Docs", InaccessibleLinksCount: 2},
+							{URL: "https://example.com/docs/login", Depth: 1, URLID: "g-h-i", HaveLoginForm: true},
+							{URL: "https://example.com/docs/guide.pdf", Depth: 1, Error: "unsupported content type"},
+						},
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 1, 0, 0, time.UTC),
+					},
+				},
+				&rest.CrawlResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeCrawlService) {
+				s.FindReturns(internal.Crawl{},
+					internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "not found",
+					Error:  "find failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeCrawlService) {
+				s.FindReturns(internal.Crawl{},
+					errors.New("service error"))
+			},
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Type:   "/problems/internal",
+					Title:  "Internal error",
+					Status: http.StatusInternalServerError,
+					Error:  "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeCrawlService{}
+			tt.setup(svc)
+
+			rest.NewCrawlHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/crawls/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
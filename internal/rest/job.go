@@ -0,0 +1,83 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+//go:generate counterfeiter -o resttesting/job_service.gen.go . JobService
+
+// JobService
+type JobService interface {
+	Find(ctx context.Context, id string) (internal.Job, error)
+}
+
+// JobHandler
+type JobHandler struct {
+	svc JobService
+}
+
+// NewJobHandler
+func NewJobHandler(svc JobService) *JobHandler {
+	return &JobHandler{
+		svc: svc,
+	}
+}
+
+// Register connects the handlers to the router.
+func (j *JobHandler) Register(r *mux.Router) {
+	r.HandleFunc(fmt.Sprintf("/jobs/{id:%s}", uuidRegEx), j.find).Methods(http.MethodGet)
+}
+
+// Job is a URL analysis running in the background, URLID refers to the resulting URL once it succeeded and
+// WatchID to the watch that enqueued it.
+type Job struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Status    string    `json:"status"`
+	URLID     string    `json:"URLId,omitempty"`
+	WatchID   string    `json:"watchId,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+func newJob(job internal.Job) Job {
+	return Job{
+		ID:        job.ID,
+		URL:       job.URL,
+		Status:    string(job.Status),
+		URLID:     job.URLID,
+		WatchID:   job.WatchID,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+}
+
+// ReadJobResponse defines the response returned back after searching one job.
+type ReadJobResponse struct {
+	Job Job `json:"job"`
+}
+
+func (j *JobHandler) find(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	job, err := j.svc.Find(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find failed", err)
+		return
+	}
+
+	renderResponse(w,
+		&ReadJobResponse{
+			Job: newJob(job),
+		},
+		http.StatusOK)
+}
@@ -0,0 +1,119 @@
+package rest_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/rest"
+	"github.com/Oguzyildirim/url-info/internal/rest/resttesting"
+)
+
+func TestJobs_Find(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeJobService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeJobService) {
+				s.FindReturns(
+					internal.Job{
+						ID:        "a-b-c",
+						URL:       "https://example.com",
+						Status:    internal.JobStatusSucceeded,
+						URLID:     "d-e-f",
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 5, 0, time.UTC),
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadJobResponse{
+					Job: rest.Job{
+						ID:        "a-b-c",
+						URL:       "https://example.com",
+						Status:    "succeeded",
+						URLID:     "d-e-f",
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 5, 0, time.UTC),
+					},
+				},
+				&rest.ReadJobResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeJobService) {
+				s.FindReturns(internal.Job{},
+					internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "not found",
+					Error:  "find failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeJobService) {
+				s.FindReturns(internal.Job{},
+					errors.New("service error"))
+			},
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Type:   "/problems/internal",
+					Title:  "Internal error",
+					Status: http.StatusInternalServerError,
+					Error:  "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeJobService{}
+			tt.setup(svc)
+
+			rest.NewJobHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/jobs/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
@@ -40,12 +40,418 @@ func NewOpenAPI3() openapi3.T {
 		"URL": openapi3.NewSchemaRef("",
 			openapi3.NewObjectSchema().
 				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("normalizedURL", openapi3.NewStringSchema()).
+				WithProperty("finalURL", openapi3.NewStringSchema()).
+				WithPropertyRef("redirects", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: openapi3.NewSchemaRef("",
+							openapi3.NewObjectSchema().
+								WithProperty("url", openapi3.NewStringSchema()).
+								WithProperty("statusCode", openapi3.NewInt32Schema())),
+					},
+				}).
+				WithProperty("statusCode", openapi3.NewInt32Schema()).
+				WithProperty("contentType", openapi3.NewStringSchema()).
+				WithProperty("contentLength", openapi3.NewInt64Schema()).
+				WithProperty("fetchDurationMs", openapi3.NewInt64Schema()).
 				WithProperty("HTMLVersion", openapi3.NewStringSchema()).
-				WithProperty("headingsCount", openapi3.NewStringSchema()).
+				WithPropertyRef("doctype", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Doctype",
+				}).
+				WithPropertyRef("headings", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Headings",
+				}).
 				WithProperty("pageTitle", openapi3.NewStringSchema()).
 				WithProperty("linksCount", openapi3.NewInt32Schema()).
 				WithProperty("inaccessibleLinksCount", openapi3.NewInt32Schema()).
-				WithProperty("HaveLoginForm", openapi3.NewBoolSchema())),
+				WithProperty("internalLinksCount", openapi3.NewInt32Schema()).
+				WithProperty("externalLinksCount", openapi3.NewInt32Schema()).
+				WithProperty("nofollowLinksCount", openapi3.NewInt32Schema()).
+				WithProperty("sponsoredLinksCount", openapi3.NewInt32Schema()).
+				WithProperty("UGCLinksCount", openapi3.NewInt32Schema()).
+				WithProperty("unsafeBlankLinksCount", openapi3.NewInt32Schema()).
+				WithProperty("HaveLoginForm", openapi3.NewBoolSchema()).
+				WithPropertyRef("forms", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: &openapi3.SchemaRef{
+							Ref: "#/components/schemas/Form",
+						},
+					},
+				}).
+				WithPropertyRef("sections", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type:        "object",
+						Description: "Findings of the analyzers without a dedicated property, keyed by analyzer name.",
+						Properties: openapi3.Schemas{
+							"metadata": &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Metadata",
+							},
+							"structuredData": &openapi3.SchemaRef{
+								Ref: "#/components/schemas/StructuredData",
+							},
+							"subresources": &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Subresources",
+							},
+							"security": &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Security",
+							},
+							"accessibility": &openapi3.SchemaRef{
+								Value: &openapi3.Schema{
+									Type: "object",
+									Properties: openapi3.Schemas{
+										"issues": &openapi3.SchemaRef{
+											Value: &openapi3.Schema{
+												Type: "array",
+												Items: &openapi3.SchemaRef{
+													Ref: "#/components/schemas/AccessibilityIssue",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}).
+				WithProperty("createdAt", openapi3.NewDateTimeSchema())),
+		"Metadata": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("description", openapi3.NewStringSchema()).
+				WithProperty("descriptionLength", openapi3.NewInt32Schema()).
+				WithProperty("robots", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema())).
+				WithProperty("xRobotsTag", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema())).
+				WithProperty("canonical", openapi3.NewStringSchema()).
+				WithProperty("canonicalSelf", openapi3.NewBoolSchema()).
+				WithPropertyRef("hreflang", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: openapi3.NewSchemaRef("",
+							openapi3.NewObjectSchema().
+								WithProperty("lang", openapi3.NewStringSchema()).
+								WithProperty("url", openapi3.NewStringSchema())),
+					},
+				}).
+				WithProperty("viewport", openapi3.NewStringSchema()).
+				WithProperty("charset", openapi3.NewStringSchema()).
+				WithProperty("warnings", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema().
+						WithEnum("title.missing", "title.duplicate", "description.missing", "description.duplicate")))),
+		"StructuredData": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("openGraph", openapi3.NewObjectSchema().
+					WithAdditionalProperties(openapi3.NewStringSchema())).
+				WithProperty("twitterCard", openapi3.NewObjectSchema().
+					WithAdditionalProperties(openapi3.NewStringSchema())).
+				WithProperty("openGraphMissing", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema())).
+				WithProperty("twitterCardMissing", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema())).
+				WithPropertyRef("jsonld", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: openapi3.NewSchemaRef("",
+							openapi3.NewObjectSchema().
+								WithProperty("data", openapi3.NewObjectSchema()).
+								WithProperty("error", openapi3.NewStringSchema())),
+					},
+				}).
+				WithPropertyRef("items", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: &openapi3.SchemaRef{
+							Ref: "#/components/schemas/StructuredDataItem",
+						},
+					},
+				})),
+		"StructuredDataItem": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("format", openapi3.NewStringSchema().
+					WithEnum("jsonld", "microdata")).
+				WithProperty("types", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema())).
+				WithProperty("missingFields", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema()))),
+		"Subresources": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithPropertyRef("items", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: &openapi3.SchemaRef{
+							Ref: "#/components/schemas/Subresource",
+						},
+					},
+				}).
+				WithProperty("thirdPartyCount", openapi3.NewInt32Schema()).
+				WithProperty("mixedContentCount", openapi3.NewInt32Schema()).
+				WithProperty("missingIntegrityCount", openapi3.NewInt32Schema()).
+				WithProperty("checked", openapi3.NewBoolSchema())),
+		"Subresource": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("type", openapi3.NewStringSchema().
+					WithEnum("script", "stylesheet", "image", "iframe", "font", "media")).
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("thirdParty", openapi3.NewBoolSchema()).
+				WithProperty("mixedContent", openapi3.NewBoolSchema()).
+				WithProperty("missingIntegrity", openapi3.NewBoolSchema()).
+				WithProperty("integrity", openapi3.NewStringSchema()).
+				WithProperty("statusClass", openapi3.NewStringSchema().
+					WithEnum("2xx", "3xx", "4xx", "5xx", "error", "skipped")).
+				WithProperty("statusCode", openapi3.NewInt32Schema()).
+				WithProperty("error", openapi3.NewStringSchema())),
+		"Security": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("grade", openapi3.NewStringSchema().
+					WithEnum("A", "B", "C", "D", "F")).
+				WithProperty("score", openapi3.NewInt32Schema()).
+				WithProperty("hsts", openapi3.NewObjectSchema().
+					WithProperty("present", openapi3.NewBoolSchema()).
+					WithProperty("maxAge", openapi3.NewInt64Schema()).
+					WithProperty("includeSubDomains", openapi3.NewBoolSchema()).
+					WithProperty("preload", openapi3.NewBoolSchema())).
+				WithProperty("csp", openapi3.NewObjectSchema().
+					WithProperty("present", openapi3.NewBoolSchema()).
+					WithProperty("directives", openapi3.NewObjectSchema().
+						WithAdditionalProperties(openapi3.NewArraySchema().
+							WithItems(openapi3.NewStringSchema()))).
+					WithProperty("unsafeInline", openapi3.NewBoolSchema()).
+					WithProperty("unsafeEval", openapi3.NewBoolSchema())).
+				WithProperty("xFrameOptions", openapi3.NewStringSchema()).
+				WithProperty("xContentTypeOptions", openapi3.NewStringSchema()).
+				WithProperty("referrerPolicy", openapi3.NewStringSchema()).
+				WithProperty("permissionsPolicy", openapi3.NewStringSchema()).
+				WithPropertyRef("cookies", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: openapi3.NewSchemaRef("",
+							openapi3.NewObjectSchema().
+								WithProperty("name", openapi3.NewStringSchema()).
+								WithProperty("secure", openapi3.NewBoolSchema()).
+								WithProperty("httpOnly", openapi3.NewBoolSchema()).
+								WithProperty("sameSite", openapi3.NewStringSchema())),
+					},
+				}).
+				WithProperty("tls", openapi3.NewObjectSchema().
+					WithNullable().
+					WithProperty("version", openapi3.NewStringSchema()).
+					WithProperty("cipherSuite", openapi3.NewStringSchema()).
+					WithProperty("subject", openapi3.NewStringSchema()).
+					WithProperty("issuer", openapi3.NewStringSchema()).
+					WithProperty("notAfter", openapi3.NewDateTimeSchema()).
+					WithProperty("expiresInDays", openapi3.NewInt32Schema())).
+				WithProperty("warnings", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema().
+						WithEnum("tls.missing", "tls.expiring", "hsts.missing",
+							"hsts.short-max-age", "csp.missing", "csp.unsafe-inline", "csp.unsafe-eval",
+							"x-frame-options.missing", "x-content-type-options.missing", "referrer-policy.missing",
+							"permissions-policy.missing", "cookie.insecure", "cookie.no-httponly", "cookie.no-samesite")))),
+		"AccessibilityIssue": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("rule", openapi3.NewStringSchema().
+					WithEnum("image-alt", "input-label", "html-lang", "heading-order", "link-name", "button-name",
+						"duplicate-id", "table-headers")).
+				WithProperty("severity", openapi3.NewStringSchema().
+					WithEnum("critical", "serious", "moderate", "minor")).
+				WithProperty("location", openapi3.NewStringSchema()).
+				WithProperty("message", openapi3.NewStringSchema())),
+		"Doctype": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("name", openapi3.NewStringSchema()).
+				WithProperty("publicID", openapi3.NewStringSchema()).
+				WithProperty("systemID", openapi3.NewStringSchema()).
+				WithProperty("mode", openapi3.NewStringSchema().
+					WithEnum("noQuirks", "limitedQuirks", "quirks"))),
+		"Headings": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("h1", openapi3.NewInt32Schema()).
+				WithProperty("h2", openapi3.NewInt32Schema()).
+				WithProperty("h3", openapi3.NewInt32Schema()).
+				WithProperty("h4", openapi3.NewInt32Schema()).
+				WithProperty("h5", openapi3.NewInt32Schema()).
+				WithProperty("h6", openapi3.NewInt32Schema()).
+				WithPropertyRef("outline", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: openapi3.NewSchemaRef("",
+							openapi3.NewObjectSchema().
+								WithProperty("level", openapi3.NewInt32Schema().
+									WithMin(1).
+									WithMax(6)).
+								WithProperty("text", openapi3.NewStringSchema())),
+					},
+				})),
+		"Form": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("kind", openapi3.NewStringSchema().
+					WithEnum("login", "signup", "passwordReset", "other")).
+				WithProperty("action", openapi3.NewStringSchema()).
+				WithProperty("method", openapi3.NewStringSchema()).
+				WithPropertyRef("fields", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: openapi3.NewSchemaRef("",
+							openapi3.NewObjectSchema().
+								WithProperty("name", openapi3.NewStringSchema()).
+								WithProperty("type", openapi3.NewStringSchema()).
+								WithProperty("required", openapi3.NewBoolSchema())),
+					},
+				})),
+		"Problem": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("type", openapi3.NewStringSchema().
+					WithEnum(
+						"/problems/internal",
+						"/problems/not-found",
+						"/problems/invalid-argument",
+						"/problems/forbidden-target",
+						"/problems/upstream-unreachable",
+						"/problems/upstream-status",
+						"/problems/timeout",
+						"/problems/too-large",
+						"/problems/unsupported-content-type",
+						"/problems/conflict",
+						"/problems/unauthorized",
+						"/problems/rate-limited",
+						"/problems/disallowed-by-robots",
+					)).
+				WithProperty("title", openapi3.NewStringSchema()).
+				WithProperty("status", openapi3.NewInt32Schema()).
+				WithProperty("detail", openapi3.NewStringSchema()).
+				WithProperty("upstreamStatus", openapi3.NewInt32Schema()).
+				WithProperty("error", openapi3.NewStringSchema())),
+		"Job": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("status", openapi3.NewStringSchema().
+					WithEnum("queued", "running", "succeeded", "failed")).
+				WithProperty("URLId", openapi3.NewUUIDSchema()).
+				WithProperty("watchId", openapi3.NewUUIDSchema()).
+				WithProperty("error", openapi3.NewStringSchema()).
+				WithProperty("createdAt", openapi3.NewDateTimeSchema()).
+				WithProperty("updatedAt", openapi3.NewDateTimeSchema())),
+		"Watch": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("schedule", openapi3.NewStringSchema()).
+				WithProperty("nextRunAt", openapi3.NewDateTimeSchema()).
+				WithProperty("lastRunAt", openapi3.NewDateTimeSchema()).
+				WithProperty("lastJobId", openapi3.NewUUIDSchema()).
+				WithProperty("lastStatus", openapi3.NewStringSchema().
+					WithEnum("queued", "running", "succeeded", "failed")).
+				WithProperty("lastURLId", openapi3.NewUUIDSchema()).
+				WithProperty("lastError", openapi3.NewStringSchema()).
+				WithProperty("createdAt", openapi3.NewDateTimeSchema()).
+				WithProperty("updatedAt", openapi3.NewDateTimeSchema())),
+		"Webhook": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("events", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema().
+						WithEnum("analysis.completed", "links.broken_increased", "title.changed", "fetch.failed"))).
+				WithProperty("secret", openapi3.NewStringSchema()).
+				WithProperty("createdAt", openapi3.NewDateTimeSchema())),
+		"WebhookDelivery": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("webhookId", openapi3.NewUUIDSchema()).
+				WithProperty("event", openapi3.NewStringSchema()).
+				WithProperty("payload", openapi3.NewObjectSchema()).
+				WithProperty("status", openapi3.NewStringSchema().
+					WithEnum("pending", "succeeded", "failed")).
+				WithProperty("attempts", openapi3.NewInt32Schema()).
+				WithProperty("nextAttemptAt", openapi3.NewDateTimeSchema()).
+				WithProperty("responseStatus", openapi3.NewInt32Schema()).
+				WithProperty("error", openapi3.NewStringSchema()).
+				WithProperty("createdAt", openapi3.NewDateTimeSchema()).
+				WithProperty("updatedAt", openapi3.NewDateTimeSchema())),
+		"Crawl": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("scope", openapi3.NewStringSchema().
+					WithEnum("host", "pathPrefix")).
+				WithProperty("maxDepth", openapi3.NewInt32Schema()).
+				WithProperty("maxPages", openapi3.NewInt32Schema()).
+				WithProperty("status", openapi3.NewStringSchema().
+					WithEnum("queued", "running", "succeeded", "failed")).
+				WithProperty("summary", openapi3.NewObjectSchema().
+					WithProperty("pagesCrawled", openapi3.NewInt32Schema()).
+					WithProperty("pagesFailed", openapi3.NewInt32Schema()).
+					WithProperty("brokenLinksCount", openapi3.NewInt32Schema()).
+					WithProperty("pagesWithoutTitleCount", openapi3.NewInt32Schema()).
+					WithProperty("pagesWithLoginFormCount", openapi3.NewInt32Schema())).
+				WithPropertyRef("pages", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: openapi3.NewSchemaRef("",
+							openapi3.NewObjectSchema().
+								WithProperty("url", openapi3.NewStringSchema()).
+								WithProperty("depth", openapi3.NewInt32Schema()).
+								WithProperty("URLId", openapi3.NewUUIDSchema()).
+								WithProperty("pageTitle", openapi3.NewStringSchema()).
+								WithProperty("inaccessibleLinksCount", openapi3.NewInt32Schema()).
+								WithProperty("haveLoginForm", openapi3.NewBoolSchema()).
+								WithProperty("error", openapi3.NewStringSchema())),
+					},
+				}).
+				WithProperty("error", openapi3.NewStringSchema()).
+				WithProperty("createdAt", openapi3.NewDateTimeSchema()).
+				WithProperty("updatedAt", openapi3.NewDateTimeSchema())),
+		"Link": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("href", openapi3.NewStringSchema()).
+				WithProperty("resolvedURL", openapi3.NewStringSchema()).
+				WithProperty("text", openapi3.NewStringSchema()).
+				WithProperty("external", openapi3.NewBoolSchema()).
+				WithProperty("statusClass", openapi3.NewStringSchema().
+					WithEnum("2xx", "3xx", "4xx", "5xx", "error", "skipped")).
+				WithProperty("statusCode", openapi3.NewInt32Schema()).
+				WithProperty("error", openapi3.NewStringSchema()).
+				WithProperty("latencyMs", openapi3.NewInt64Schema())),
+		"FieldChange": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("field", openapi3.NewStringSchema()).
+				WithProperty("from", openapi3.NewSchema()).
+				WithProperty("to", openapi3.NewSchema())),
+		"URLDiff": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithPropertyRef("from", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/URL",
+				}).
+				WithPropertyRef("to", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/URL",
+				}).
+				WithPropertyRef("changes", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: &openapi3.SchemaRef{
+							Ref: "#/components/schemas/FieldChange",
+						},
+					},
+				}).
+				WithPropertyRef("newBrokenLinks", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: &openapi3.SchemaRef{
+							Ref: "#/components/schemas/Link",
+						},
+					},
+				}).
+				WithPropertyRef("fixedLinks", &openapi3.SchemaRef{
+					Value: &openapi3.Schema{
+						Type: "array",
+						Items: &openapi3.SchemaRef{
+							Ref: "#/components/schemas/Link",
+						},
+					},
+				})),
 	}
 
 	swagger.Components.RequestBodies = openapi3.RequestBodies{
@@ -55,32 +461,231 @@ func NewOpenAPI3() openapi3.T {
 				WithRequired(true).
 				WithJSONSchema(openapi3.NewSchema().
 					WithProperty("URL", openapi3.NewStringSchema().
-						WithMinLength(10)),
+						WithMinLength(10)).
+					WithProperty("async", openapi3.NewBoolSchema()).
+					WithProperty("maxAge", openapi3.NewInt32Schema().
+						WithMin(0).
+						WithMax(31536000)),
 				),
 		},
 	}
 
+	swagger.Components.RequestBodies["CreateWatchesRequest"] = &openapi3.RequestBodyRef{
+		Value: openapi3.NewRequestBody().
+			WithDescription("Request used for creating a watch, schedule is an interval like 1h or @every 30m, or a cron expression evaluated in UTC.").
+			WithRequired(true).
+			WithJSONSchema(openapi3.NewSchema().
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("schedule", openapi3.NewStringSchema()),
+			),
+	}
+
+	swagger.Components.RequestBodies["CreateWebhooksRequest"] = &openapi3.RequestBodyRef{
+		Value: openapi3.NewRequestBody().
+			WithDescription("Request used for creating a webhook subscribed to any of analysis.completed, links.broken_increased, title.changed and fetch.failed, a secret is generated when none is given.").
+			WithRequired(true).
+			WithJSONSchema(openapi3.NewSchema().
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("events", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema())).
+				WithProperty("secret", openapi3.NewStringSchema()),
+			),
+	}
+
+	swagger.Components.RequestBodies["CreateCrawlsRequest"] = &openapi3.RequestBodyRef{
+		Value: openapi3.NewRequestBody().
+			WithDescription("Request used for creating a crawl following the internal links of the seed URL, scope is either host or pathPrefix and omitted values use the defaults.").
+			WithRequired(true).
+			WithJSONSchema(openapi3.NewSchema().
+				WithProperty("url", openapi3.NewStringSchema()).
+				WithProperty("scope", openapi3.NewStringSchema().
+					WithDefault("host")).
+				WithProperty("maxDepth", openapi3.NewInt32Schema().
+					WithMin(1).
+					WithMax(10).
+					WithDefault(2)).
+				WithProperty("maxPages", openapi3.NewInt32Schema().
+					WithMin(1).
+					WithMax(500).
+					WithDefault(50)),
+			),
+	}
+
+	searchURLsResponse := openapi3.NewResponse().
+		WithDescription("Response returned back after creating URLs, 200 when a stored analysis is reused.").
+		WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+			WithPropertyRef("URL", &openapi3.SchemaRef{
+				Ref: "#/components/schemas/URL",
+			})))
+
+	searchURLsResponse.Headers = openapi3.Headers{
+		"X-Cache": &openapi3.HeaderRef{
+			Value: &openapi3.Header{
+				Description: "HIT when a stored analysis is reused, MISS otherwise.",
+				Schema: openapi3.NewStringSchema().
+					WithEnum("HIT", "MISS").
+					NewRef(),
+			},
+		},
+	}
+
 	swagger.Components.Responses = openapi3.Responses{
 		"ErrorResponse": &openapi3.ResponseRef{
 			Value: openapi3.NewResponse().
-				WithDescription("Response when errors happen.").
-				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
-					WithProperty("error", openapi3.NewStringSchema()))),
+				WithDescription("RFC 7807 problem returned when errors happen.").
+				WithContent(openapi3.NewContentWithSchemaRef(&openapi3.SchemaRef{
+					Ref: "#/components/schemas/Problem",
+				}, []string{"application/problem+json", "application/json"})),
 		},
 		"SearchURLsResponse": &openapi3.ResponseRef{
+			Value: searchURLsResponse,
+		},
+		"ReadURLsResponse": &openapi3.ResponseRef{
 			Value: openapi3.NewResponse().
-				WithDescription("Response returned back after creating URLs.").
+				WithDescription("Response returned back after searching one URL.").
 				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
 					WithPropertyRef("URL", &openapi3.SchemaRef{
 						Ref: "#/components/schemas/URL",
 					}))),
 		},
-		"ReadURLsResponse": &openapi3.ResponseRef{
+		"URLsPageResponse": &openapi3.ResponseRef{
 			Value: openapi3.NewResponse().
-				WithDescription("Response returned back after searching one URL.").
+				WithDescription("Response returned back after listing URLs.").
 				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
-					WithPropertyRef("URL", &openapi3.SchemaRef{
-						Ref: "#/components/schemas/URL",
+					WithPropertyRef("URLs", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/URL",
+							},
+						},
+					}).
+					WithProperty("nextCursor", openapi3.NewStringSchema()))),
+		},
+		"URLLinksResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after searching the links of one URL.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("links", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Link",
+							},
+						},
+					}))),
+		},
+		"URLAccessibilityResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after searching the accessibility issues of one URL.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("issues", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/AccessibilityIssue",
+							},
+						},
+					}))),
+		},
+		"URLHistoryResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after searching the analyses of one URL.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("URLs", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/URL",
+							},
+						},
+					}))),
+		},
+		"URLDiffResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after comparing two analyses.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("diff", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/URLDiff",
+					}))),
+		},
+		"EnqueueURLsResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after enqueuing a URL analysis.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("job", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Job",
+					}))),
+		},
+		"ReadJobsResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after searching one job.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("job", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Job",
+					}))),
+		},
+		"WatchResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after creating or searching one watch.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("watch", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Watch",
+					}))),
+		},
+		"WatchesResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after listing watches.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("watches", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Watch",
+							},
+						},
+					}))),
+		},
+		"WebhookResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after creating or searching one webhook, the secret is only returned on creation.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("webhook", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Webhook",
+					}))),
+		},
+		"WebhooksResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after listing webhooks.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("webhooks", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Webhook",
+							},
+						},
+					}))),
+		},
+		"WebhookDeliveriesResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after searching the latest deliveries of a webhook, newest first.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("deliveries", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/WebhookDelivery",
+							},
+						},
+					}))),
+		},
+		"CrawlResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after creating or searching one crawl.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("crawl", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Crawl",
 					}))),
 		},
 		"ReadURLsByCountryResponse": &openapi3.ResponseRef{
@@ -95,6 +700,59 @@ func NewOpenAPI3() openapi3.T {
 
 	swagger.Paths = openapi3.Paths{
 		"/URLs": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ListURLs",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewQueryParameter("host").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("HTMLVersion").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("haveLoginForm").
+							WithSchema(openapi3.NewBoolSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("createdFrom").
+							WithSchema(openapi3.NewDateTimeSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("createdTo").
+							WithSchema(openapi3.NewDateTimeSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("sort").
+							WithSchema(openapi3.NewStringSchema().
+								WithEnum("createdAt", "inaccessibleLinksCount").
+								WithDefault("createdAt")),
+					},
+					{
+						Value: openapi3.NewQueryParameter("cursor").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("limit").
+							WithSchema(openapi3.NewInt32Schema().
+								WithMin(1).
+								WithMax(100).
+								WithDefault(20)),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/URLsPageResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
 			Post: &openapi3.Operation{
 				OperationID: "CreateURL",
 				RequestBody: &openapi3.RequestBodyRef{
@@ -107,9 +765,30 @@ func NewOpenAPI3() openapi3.T {
 					"500": &openapi3.ResponseRef{
 						Ref: "#/components/responses/ErrorResponse",
 					},
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/SearchURLsResponse",
+					},
 					"201": &openapi3.ResponseRef{
 						Ref: "#/components/responses/SearchURLsResponse",
 					},
+					"202": &openapi3.ResponseRef{
+						Ref: "#/components/responses/EnqueueURLsResponse",
+					},
+					"422": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"413": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"415": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"502": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"504": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
 				},
 			},
 		},
@@ -155,6 +834,373 @@ func NewOpenAPI3() openapi3.T {
 				},
 			},
 		},
+		"/URLs/{URLId}/links": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadURLLinks",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("URLId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("status").
+							WithSchema(openapi3.NewStringSchema().
+								WithEnum("2xx", "3xx", "4xx", "5xx", "error", "skipped")),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/URLLinksResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("URL not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/URLs/{URLId}/accessibility": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadURLAccessibility",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("URLId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("rule").
+							WithSchema(openapi3.NewStringSchema().
+								WithEnum("image-alt", "input-label", "html-lang", "heading-order", "link-name",
+									"button-name", "duplicate-id", "table-headers")),
+					},
+					{
+						Value: openapi3.NewQueryParameter("severity").
+							WithSchema(openapi3.NewStringSchema().
+								WithEnum("critical", "serious", "moderate", "minor")),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/URLAccessibilityResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("URL or accessibility analysis not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/URLs/history": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadURLHistory",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewQueryParameter("url").
+							WithRequired(true).
+							WithSchema(openapi3.NewStringSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/URLHistoryResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/URLs/{URLId}/diff/{otherURLId}": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadURLDiff",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("URLId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewPathParameter("otherURLId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/URLDiffResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/jobs/{jobId}": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadJob",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("jobId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ReadJobsResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Job not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/watches": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ListWatches",
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/WatchesResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Post: &openapi3.Operation{
+				OperationID: "CreateWatch",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/CreateWatchesRequest",
+				},
+				Responses: openapi3.Responses{
+					"201": &openapi3.ResponseRef{
+						Ref: "#/components/responses/WatchResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/watches/{watchId}": &openapi3.PathItem{
+			Delete: &openapi3.Operation{
+				OperationID: "DeleteWatch",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("watchId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Watch deleted"),
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Get: &openapi3.Operation{
+				OperationID: "ReadWatch",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("watchId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/WatchResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/crawls": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "CreateCrawl",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/CreateCrawlsRequest",
+				},
+				Responses: openapi3.Responses{
+					"202": &openapi3.ResponseRef{
+						Ref: "#/components/responses/CrawlResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/crawls/{crawlId}": &openapi3.PathItem{
+			Delete: &openapi3.Operation{
+				OperationID: "DeleteCrawl",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("crawlId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Crawl deleted"),
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Get: &openapi3.Operation{
+				OperationID: "ReadCrawl",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("crawlId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/CrawlResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/webhooks": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ListWebhooks",
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/WebhooksResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Post: &openapi3.Operation{
+				OperationID: "CreateWebhook",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/CreateWebhooksRequest",
+				},
+				Responses: openapi3.Responses{
+					"201": &openapi3.ResponseRef{
+						Ref: "#/components/responses/WebhookResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/webhooks/{webhookId}": &openapi3.PathItem{
+			Delete: &openapi3.Operation{
+				OperationID: "DeleteWebhook",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("webhookId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Webhook deleted"),
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Get: &openapi3.Operation{
+				OperationID: "ReadWebhook",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("webhookId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/WebhookResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/webhooks/{webhookId}/deliveries": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadWebhookDeliveries",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("webhookId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/WebhookDeliveriesResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
 	}
 
 	return swagger
@@ -10,27 +10,64 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
-// ErrorResponse represents a response containing an error message.
+// problemTypePrefix prefixes the slug of each problem type to build its URI.
+const problemTypePrefix = "/problems/"
+
+// ErrorResponse represents an RFC 7807 problem, Error repeats the message of the failed operation.
 type ErrorResponse struct {
-	Error string `json:"error"`
+	Type           string `json:"type"`
+	Title          string `json:"title"`
+	Status         int    `json:"status"`
+	Detail         string `json:"detail,omitempty"`
+	UpstreamStatus int    `json:"upstreamStatus,omitempty"`
+	Error          string `json:"error"`
+}
+
+// problem defines how errors with the same code are rendered.
+type problem struct {
+	status int
+	slug   string
+	title  string
+}
+
+var problems = map[internal.ErrorCode]problem{
+	internal.ErrorCodeUnknown:                {http.StatusInternalServerError, "internal", "Internal error"},
+	internal.ErrorCodeNotFound:               {http.StatusNotFound, "not-found", "Resource not found"},
+	internal.ErrorCodeInvalidArgument:        {http.StatusBadRequest, "invalid-argument", "Invalid argument"},
+	internal.ErrorCodeForbiddenTarget:        {http.StatusUnprocessableEntity, "forbidden-target", "Target address not allowed"},
+	internal.ErrorCodeUpstreamUnreachable:    {http.StatusBadGateway, "upstream-unreachable", "Upstream unreachable"},
+	internal.ErrorCodeUpstreamStatus:         {http.StatusBadGateway, "upstream-status", "Unexpected upstream status"},
+	internal.ErrorCodeTimeout:                {http.StatusGatewayTimeout, "timeout", "Upstream timed out"},
+	internal.ErrorCodeTooLarge:               {http.StatusRequestEntityTooLarge, "too-large", "Upstream response too large"},
+	internal.ErrorCodeUnsupportedContentType: {http.StatusUnsupportedMediaType, "unsupported-content-type", "Unsupported content type"},
+	internal.ErrorCodeConflict:               {http.StatusConflict, "conflict", "Conflict"},
+	internal.ErrorCodeUnauthorized:           {http.StatusUnauthorized, "unauthorized", "Unauthorized"},
+	internal.ErrorCodeRateLimited:            {http.StatusTooManyRequests, "rate-limited", "Rate limited"},
+	internal.ErrorCodeDisallowedByRobots:     {http.StatusUnprocessableEntity, "disallowed-by-robots", "Disallowed by robots.txt"},
 }
 
 func renderErrorResponse(ctx context.Context, w http.ResponseWriter, msg string, err error) {
+	p := problems[internal.ErrorCodeUnknown]
 	resp := ErrorResponse{Error: msg}
-	status := http.StatusInternalServerError
 
 	var ierr *internal.Error
 	if !errors.As(err, &ierr) {
 		resp.Error = "internal error"
-	} else {
-		switch ierr.Code() {
-		case internal.ErrorCodeNotFound:
-			status = http.StatusNotFound
-		case internal.ErrorCodeInvalidArgument:
-			status = http.StatusBadRequest
+	} else if known, ok := problems[ierr.Code()]; ok {
+		p = known
+
+		// messages of unknown errors may include details about the datastore
+		if ierr.Code() != internal.ErrorCodeUnknown {
+			resp.Detail = ierr.Error()
 		}
+
+		resp.UpstreamStatus = ierr.UpstreamStatus()
 	}
 
+	resp.Type = problemTypePrefix + p.slug
+	resp.Title = p.title
+	resp.Status = p.status
+
 	if err != nil {
 		_, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("UrlTracer").Start(ctx, "rest.renderErrorResponse")
 		defer span.End()
@@ -38,11 +75,15 @@ func renderErrorResponse(ctx context.Context, w http.ResponseWriter, msg string,
 		span.RecordError(err)
 	}
 
-	renderResponse(w, resp, status)
+	render(w, "application/problem+json", resp, p.status)
 }
 
 func renderResponse(w http.ResponseWriter, res interface{}, status int) {
-	w.Header().Set("Content-Type", "application/json")
+	render(w, "application/json", res, status)
+}
+
+func render(w http.ResponseWriter, contentType string, res interface{}, status int) {
+	w.Header().Set("Content-Type", contentType)
 
 	content, err := json.Marshal(res)
 	if err != nil {
@@ -22,6 +22,35 @@ type FakeURLService struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DiffStub        func(context.Context, string, string) (internal.URLDiff, error)
+	diffMutex       sync.RWMutex
+	diffArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	diffReturns struct {
+		result1 internal.URLDiff
+		result2 error
+	}
+	diffReturnsOnCall map[int]struct {
+		result1 internal.URLDiff
+		result2 error
+	}
+	EnqueueStub        func(context.Context, internal.SearchParams) (internal.Job, error)
+	enqueueMutex       sync.RWMutex
+	enqueueArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.SearchParams
+	}
+	enqueueReturns struct {
+		result1 internal.Job
+		result2 error
+	}
+	enqueueReturnsOnCall map[int]struct {
+		result1 internal.Job
+		result2 error
+	}
 	FindStub        func(context.Context, string) (internal.URL, error)
 	findMutex       sync.RWMutex
 	findArgsForCall []struct {
@@ -36,18 +65,76 @@ type FakeURLService struct {
 		result1 internal.URL
 		result2 error
 	}
-	SearchStub        func(context.Context, string) (internal.URL, error)
+	FindAccessibilityIssuesStub        func(context.Context, string, internal.AccessibilityIssuesParams) ([]internal.AccessibilityIssue, error)
+	findAccessibilityIssuesMutex       sync.RWMutex
+	findAccessibilityIssuesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 internal.AccessibilityIssuesParams
+	}
+	findAccessibilityIssuesReturns struct {
+		result1 []internal.AccessibilityIssue
+		result2 error
+	}
+	findAccessibilityIssuesReturnsOnCall map[int]struct {
+		result1 []internal.AccessibilityIssue
+		result2 error
+	}
+	FindLinksStub        func(context.Context, string, internal.LinkStatusClass) ([]internal.Link, error)
+	findLinksMutex       sync.RWMutex
+	findLinksArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 internal.LinkStatusClass
+	}
+	findLinksReturns struct {
+		result1 []internal.Link
+		result2 error
+	}
+	findLinksReturnsOnCall map[int]struct {
+		result1 []internal.Link
+		result2 error
+	}
+	HistoryStub        func(context.Context, string) ([]internal.URL, error)
+	historyMutex       sync.RWMutex
+	historyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	historyReturns struct {
+		result1 []internal.URL
+		result2 error
+	}
+	historyReturnsOnCall map[int]struct {
+		result1 []internal.URL
+		result2 error
+	}
+	ListStub        func(context.Context, internal.ListURLsParams) (internal.ListURLsResult, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListURLsParams
+	}
+	listReturns struct {
+		result1 internal.ListURLsResult
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 internal.ListURLsResult
+		result2 error
+	}
+	SearchStub        func(context.Context, internal.SearchParams) (internal.SearchResult, error)
 	searchMutex       sync.RWMutex
 	searchArgsForCall []struct {
 		arg1 context.Context
-		arg2 string
+		arg2 internal.SearchParams
 	}
 	searchReturns struct {
-		result1 internal.URL
+		result1 internal.SearchResult
 		result2 error
 	}
 	searchReturnsOnCall map[int]struct {
-		result1 internal.URL
+		result1 internal.SearchResult
 		result2 error
 	}
 	invocations      map[string][][]interface{}
@@ -116,6 +203,137 @@ func (fake *FakeURLService) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeURLService) Diff(arg1 context.Context, arg2 string, arg3 string) (internal.URLDiff, error) {
+	fake.diffMutex.Lock()
+	ret, specificReturn := fake.diffReturnsOnCall[len(fake.diffArgsForCall)]
+	fake.diffArgsForCall = append(fake.diffArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DiffStub
+	fakeReturns := fake.diffReturns
+	fake.recordInvocation("Diff", []interface{}{arg1, arg2, arg3})
+	fake.diffMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeURLService) DiffCallCount() int {
+	fake.diffMutex.RLock()
+	defer fake.diffMutex.RUnlock()
+	return len(fake.diffArgsForCall)
+}
+
+func (fake *FakeURLService) DiffCalls(stub func(context.Context, string, string) (internal.URLDiff, error)) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = stub
+}
+
+func (fake *FakeURLService) DiffArgsForCall(i int) (context.Context, string, string) {
+	fake.diffMutex.RLock()
+	defer fake.diffMutex.RUnlock()
+	argsForCall := fake.diffArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeURLService) DiffReturns(result1 internal.URLDiff, result2 error) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = nil
+	fake.diffReturns = struct {
+		result1 internal.URLDiff
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) DiffReturnsOnCall(i int, result1 internal.URLDiff, result2 error) {
+	fake.diffMutex.Lock()
+	defer fake.diffMutex.Unlock()
+	fake.DiffStub = nil
+	if fake.diffReturnsOnCall == nil {
+		fake.diffReturnsOnCall = make(map[int]struct {
+			result1 internal.URLDiff
+			result2 error
+		})
+	}
+	fake.diffReturnsOnCall[i] = struct {
+		result1 internal.URLDiff
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) Enqueue(arg1 context.Context, arg2 internal.SearchParams) (internal.Job, error) {
+	fake.enqueueMutex.Lock()
+	ret, specificReturn := fake.enqueueReturnsOnCall[len(fake.enqueueArgsForCall)]
+	fake.enqueueArgsForCall = append(fake.enqueueArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.SearchParams
+	}{arg1, arg2})
+	stub := fake.EnqueueStub
+	fakeReturns := fake.enqueueReturns
+	fake.recordInvocation("Enqueue", []interface{}{arg1, arg2})
+	fake.enqueueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeURLService) EnqueueCallCount() int {
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	return len(fake.enqueueArgsForCall)
+}
+
+func (fake *FakeURLService) EnqueueCalls(stub func(context.Context, internal.SearchParams) (internal.Job, error)) {
+	fake.enqueueMutex.Lock()
+	defer fake.enqueueMutex.Unlock()
+	fake.EnqueueStub = stub
+}
+
+func (fake *FakeURLService) EnqueueArgsForCall(i int) (context.Context, internal.SearchParams) {
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	argsForCall := fake.enqueueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeURLService) EnqueueReturns(result1 internal.Job, result2 error) {
+	fake.enqueueMutex.Lock()
+	defer fake.enqueueMutex.Unlock()
+	fake.EnqueueStub = nil
+	fake.enqueueReturns = struct {
+		result1 internal.Job
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) EnqueueReturnsOnCall(i int, result1 internal.Job, result2 error) {
+	fake.enqueueMutex.Lock()
+	defer fake.enqueueMutex.Unlock()
+	fake.EnqueueStub = nil
+	if fake.enqueueReturnsOnCall == nil {
+		fake.enqueueReturnsOnCall = make(map[int]struct {
+			result1 internal.Job
+			result2 error
+		})
+	}
+	fake.enqueueReturnsOnCall[i] = struct {
+		result1 internal.Job
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeURLService) Find(arg1 context.Context, arg2 string) (internal.URL, error) {
 	fake.findMutex.Lock()
 	ret, specificReturn := fake.findReturnsOnCall[len(fake.findArgsForCall)]
@@ -181,12 +399,274 @@ func (fake *FakeURLService) FindReturnsOnCall(i int, result1 internal.URL, resul
 	}{result1, result2}
 }
 
-func (fake *FakeURLService) Search(arg1 context.Context, arg2 string) (internal.URL, error) {
+func (fake *FakeURLService) FindAccessibilityIssues(arg1 context.Context, arg2 string, arg3 internal.AccessibilityIssuesParams) ([]internal.AccessibilityIssue, error) {
+	fake.findAccessibilityIssuesMutex.Lock()
+	ret, specificReturn := fake.findAccessibilityIssuesReturnsOnCall[len(fake.findAccessibilityIssuesArgsForCall)]
+	fake.findAccessibilityIssuesArgsForCall = append(fake.findAccessibilityIssuesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 internal.AccessibilityIssuesParams
+	}{arg1, arg2, arg3})
+	stub := fake.FindAccessibilityIssuesStub
+	fakeReturns := fake.findAccessibilityIssuesReturns
+	fake.recordInvocation("FindAccessibilityIssues", []interface{}{arg1, arg2, arg3})
+	fake.findAccessibilityIssuesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeURLService) FindAccessibilityIssuesCallCount() int {
+	fake.findAccessibilityIssuesMutex.RLock()
+	defer fake.findAccessibilityIssuesMutex.RUnlock()
+	return len(fake.findAccessibilityIssuesArgsForCall)
+}
+
+func (fake *FakeURLService) FindAccessibilityIssuesCalls(stub func(context.Context, string, internal.AccessibilityIssuesParams) ([]internal.AccessibilityIssue, error)) {
+	fake.findAccessibilityIssuesMutex.Lock()
+	defer fake.findAccessibilityIssuesMutex.Unlock()
+	fake.FindAccessibilityIssuesStub = stub
+}
+
+func (fake *FakeURLService) FindAccessibilityIssuesArgsForCall(i int) (context.Context, string, internal.AccessibilityIssuesParams) {
+	fake.findAccessibilityIssuesMutex.RLock()
+	defer fake.findAccessibilityIssuesMutex.RUnlock()
+	argsForCall := fake.findAccessibilityIssuesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeURLService) FindAccessibilityIssuesReturns(result1 []internal.AccessibilityIssue, result2 error) {
+	fake.findAccessibilityIssuesMutex.Lock()
+	defer fake.findAccessibilityIssuesMutex.Unlock()
+	fake.FindAccessibilityIssuesStub = nil
+	fake.findAccessibilityIssuesReturns = struct {
+		result1 []internal.AccessibilityIssue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) FindAccessibilityIssuesReturnsOnCall(i int, result1 []internal.AccessibilityIssue, result2 error) {
+	fake.findAccessibilityIssuesMutex.Lock()
+	defer fake.findAccessibilityIssuesMutex.Unlock()
+	fake.FindAccessibilityIssuesStub = nil
+	if fake.findAccessibilityIssuesReturnsOnCall == nil {
+		fake.findAccessibilityIssuesReturnsOnCall = make(map[int]struct {
+			result1 []internal.AccessibilityIssue
+			result2 error
+		})
+	}
+	fake.findAccessibilityIssuesReturnsOnCall[i] = struct {
+		result1 []internal.AccessibilityIssue
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) FindLinks(arg1 context.Context, arg2 string, arg3 internal.LinkStatusClass) ([]internal.Link, error) {
+	fake.findLinksMutex.Lock()
+	ret, specificReturn := fake.findLinksReturnsOnCall[len(fake.findLinksArgsForCall)]
+	fake.findLinksArgsForCall = append(fake.findLinksArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 internal.LinkStatusClass
+	}{arg1, arg2, arg3})
+	stub := fake.FindLinksStub
+	fakeReturns := fake.findLinksReturns
+	fake.recordInvocation("FindLinks", []interface{}{arg1, arg2, arg3})
+	fake.findLinksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeURLService) FindLinksCallCount() int {
+	fake.findLinksMutex.RLock()
+	defer fake.findLinksMutex.RUnlock()
+	return len(fake.findLinksArgsForCall)
+}
+
+func (fake *FakeURLService) FindLinksCalls(stub func(context.Context, string, internal.LinkStatusClass) ([]internal.Link, error)) {
+	fake.findLinksMutex.Lock()
+	defer fake.findLinksMutex.Unlock()
+	fake.FindLinksStub = stub
+}
+
+func (fake *FakeURLService) FindLinksArgsForCall(i int) (context.Context, string, internal.LinkStatusClass) {
+	fake.findLinksMutex.RLock()
+	defer fake.findLinksMutex.RUnlock()
+	argsForCall := fake.findLinksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeURLService) FindLinksReturns(result1 []internal.Link, result2 error) {
+	fake.findLinksMutex.Lock()
+	defer fake.findLinksMutex.Unlock()
+	fake.FindLinksStub = nil
+	fake.findLinksReturns = struct {
+		result1 []internal.Link
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) FindLinksReturnsOnCall(i int, result1 []internal.Link, result2 error) {
+	fake.findLinksMutex.Lock()
+	defer fake.findLinksMutex.Unlock()
+	fake.FindLinksStub = nil
+	if fake.findLinksReturnsOnCall == nil {
+		fake.findLinksReturnsOnCall = make(map[int]struct {
+			result1 []internal.Link
+			result2 error
+		})
+	}
+	fake.findLinksReturnsOnCall[i] = struct {
+		result1 []internal.Link
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) History(arg1 context.Context, arg2 string) ([]internal.URL, error) {
+	fake.historyMutex.Lock()
+	ret, specificReturn := fake.historyReturnsOnCall[len(fake.historyArgsForCall)]
+	fake.historyArgsForCall = append(fake.historyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.HistoryStub
+	fakeReturns := fake.historyReturns
+	fake.recordInvocation("History", []interface{}{arg1, arg2})
+	fake.historyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeURLService) HistoryCallCount() int {
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	return len(fake.historyArgsForCall)
+}
+
+func (fake *FakeURLService) HistoryCalls(stub func(context.Context, string) ([]internal.URL, error)) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = stub
+}
+
+func (fake *FakeURLService) HistoryArgsForCall(i int) (context.Context, string) {
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	argsForCall := fake.historyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeURLService) HistoryReturns(result1 []internal.URL, result2 error) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	fake.historyReturns = struct {
+		result1 []internal.URL
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) HistoryReturnsOnCall(i int, result1 []internal.URL, result2 error) {
+	fake.historyMutex.Lock()
+	defer fake.historyMutex.Unlock()
+	fake.HistoryStub = nil
+	if fake.historyReturnsOnCall == nil {
+		fake.historyReturnsOnCall = make(map[int]struct {
+			result1 []internal.URL
+			result2 error
+		})
+	}
+	fake.historyReturnsOnCall[i] = struct {
+		result1 []internal.URL
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) List(arg1 context.Context, arg2 internal.ListURLsParams) (internal.ListURLsResult, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListURLsParams
+	}{arg1, arg2})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeURLService) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeURLService) ListCalls(stub func(context.Context, internal.ListURLsParams) (internal.ListURLsResult, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeURLService) ListArgsForCall(i int) (context.Context, internal.ListURLsParams) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeURLService) ListReturns(result1 internal.ListURLsResult, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 internal.ListURLsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) ListReturnsOnCall(i int, result1 internal.ListURLsResult, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 internal.ListURLsResult
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 internal.ListURLsResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeURLService) Search(arg1 context.Context, arg2 internal.SearchParams) (internal.SearchResult, error) {
 	fake.searchMutex.Lock()
 	ret, specificReturn := fake.searchReturnsOnCall[len(fake.searchArgsForCall)]
 	fake.searchArgsForCall = append(fake.searchArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
+		arg2 internal.SearchParams
 	}{arg1, arg2})
 	stub := fake.SearchStub
 	fakeReturns := fake.searchReturns
@@ -207,41 +687,41 @@ func (fake *FakeURLService) SearchCallCount() int {
 	return len(fake.searchArgsForCall)
 }
 
-func (fake *FakeURLService) SearchCalls(stub func(context.Context, string) (internal.URL, error)) {
+func (fake *FakeURLService) SearchCalls(stub func(context.Context, internal.SearchParams) (internal.SearchResult, error)) {
 	fake.searchMutex.Lock()
 	defer fake.searchMutex.Unlock()
 	fake.SearchStub = stub
 }
 
-func (fake *FakeURLService) SearchArgsForCall(i int) (context.Context, string) {
+func (fake *FakeURLService) SearchArgsForCall(i int) (context.Context, internal.SearchParams) {
 	fake.searchMutex.RLock()
 	defer fake.searchMutex.RUnlock()
 	argsForCall := fake.searchArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeURLService) SearchReturns(result1 internal.URL, result2 error) {
+func (fake *FakeURLService) SearchReturns(result1 internal.SearchResult, result2 error) {
 	fake.searchMutex.Lock()
 	defer fake.searchMutex.Unlock()
 	fake.SearchStub = nil
 	fake.searchReturns = struct {
-		result1 internal.URL
+		result1 internal.SearchResult
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeURLService) SearchReturnsOnCall(i int, result1 internal.URL, result2 error) {
+func (fake *FakeURLService) SearchReturnsOnCall(i int, result1 internal.SearchResult, result2 error) {
 	fake.searchMutex.Lock()
 	defer fake.searchMutex.Unlock()
 	fake.SearchStub = nil
 	if fake.searchReturnsOnCall == nil {
 		fake.searchReturnsOnCall = make(map[int]struct {
-			result1 internal.URL
+			result1 internal.SearchResult
 			result2 error
 		})
 	}
 	fake.searchReturnsOnCall[i] = struct {
-		result1 internal.URL
+		result1 internal.SearchResult
 		result2 error
 	}{result1, result2}
 }
@@ -251,8 +731,20 @@ func (fake *FakeURLService) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.diffMutex.RLock()
+	defer fake.diffMutex.RUnlock()
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
 	fake.findMutex.RLock()
 	defer fake.findMutex.RUnlock()
+	fake.findAccessibilityIssuesMutex.RLock()
+	defer fake.findAccessibilityIssuesMutex.RUnlock()
+	fake.findLinksMutex.RLock()
+	defer fake.findLinksMutex.RUnlock()
+	fake.historyMutex.RLock()
+	defer fake.historyMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
 	fake.searchMutex.RLock()
 	defer fake.searchMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -4,7 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -17,9 +21,15 @@ const uuidRegEx string = `[0-9a-fA-F]{8}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-
 
 // URLService
 type URLService interface {
-	Search(ctx context.Context, URL string) (internal.URL, error)
+	Search(ctx context.Context, params internal.SearchParams) (internal.SearchResult, error)
+	Enqueue(ctx context.Context, params internal.SearchParams) (internal.Job, error)
 	Delete(ctx context.Context, id string) error
 	Find(ctx context.Context, id string) (internal.URL, error)
+	FindLinks(ctx context.Context, id string, class internal.LinkStatusClass) ([]internal.Link, error)
+	FindAccessibilityIssues(ctx context.Context, id string, params internal.AccessibilityIssuesParams) ([]internal.AccessibilityIssue, error)
+	History(ctx context.Context, URL string) ([]internal.URL, error)
+	Diff(ctx context.Context, fromID, toID string) (internal.URLDiff, error)
+	List(ctx context.Context, params internal.ListURLsParams) (internal.ListURLsResult, error)
 }
 
 // URLHandler
@@ -37,24 +47,213 @@ func NewURLHandler(svc URLService) *URLHandler {
 // Register connects the handlers to the router.
 func (u *URLHandler) Register(r *mux.Router) {
 	r.HandleFunc("/URLs", u.search).Methods(http.MethodPost)
+	r.HandleFunc("/URLs", u.list).Methods(http.MethodGet)
+	r.HandleFunc("/URLs/history", u.history).Methods(http.MethodGet)
 	r.HandleFunc(fmt.Sprintf("/URLs/{id:%s}", uuidRegEx), u.find).Methods(http.MethodGet)
 	r.HandleFunc(fmt.Sprintf("/URLs/{id:%s}", uuidRegEx), u.delete).Methods(http.MethodDelete)
+	r.HandleFunc(fmt.Sprintf("/URLs/{id:%s}/links", uuidRegEx), u.findLinks).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/URLs/{id:%s}/accessibility", uuidRegEx), u.findAccessibilityIssues).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/URLs/{id:%s}/diff/{otherID:%s}", uuidRegEx, uuidRegEx), u.diff).Methods(http.MethodGet)
 }
 
 // URL is one of the key concepts of the Web. It is the mechanism used by browsers to retrieve any published resource on the web
 type URL struct {
-	ID                     string `json:"id"`
-	HTMLVersion            string `json:"HTMLVersion"`
-	PageTitle              string `json:"pageTitle"`
-	HeadingsCount          string `json:"headingsCount"`
-	LinksCount             int    `json:"linksCount"`
-	InaccessibleLinksCount int    `json:"inaccessibleLinksCount"`
-	HaveLoginForm          bool   `json:"haveLoginForm"`
+	ID                     string     `json:"id"`
+	URL                    string     `json:"url"`
+	NormalizedURL          string     `json:"normalizedURL"`
+	FinalURL               string     `json:"finalURL"`
+	Redirects              []Redirect `json:"redirects"`
+	StatusCode             int        `json:"statusCode"`
+	ContentType            string     `json:"contentType"`
+	ContentLength          int64      `json:"contentLength"`
+	FetchDurationMs        int64      `json:"fetchDurationMs"`
+	HTMLVersion            string     `json:"HTMLVersion"`
+	Doctype                Doctype    `json:"doctype"`
+	PageTitle              string     `json:"pageTitle"`
+	Headings               Headings   `json:"headings"`
+	LinksCount             int        `json:"linksCount"`
+	InaccessibleLinksCount int        `json:"inaccessibleLinksCount"`
+	InternalLinksCount     int        `json:"internalLinksCount"`
+	ExternalLinksCount     int        `json:"externalLinksCount"`
+	NofollowLinksCount     int        `json:"nofollowLinksCount"`
+	SponsoredLinksCount    int        `json:"sponsoredLinksCount"`
+	UGCLinksCount          int        `json:"UGCLinksCount"`
+	UnsafeBlankLinksCount  int        `json:"unsafeBlankLinksCount"`
+	HaveLoginForm          bool       `json:"haveLoginForm"`
+	Forms                  []Form     `json:"forms"`
+	// Sections holds the findings of the analyzers without a dedicated field, keyed by analyzer name
+	Sections  map[string]json.RawMessage `json:"sections,omitempty"`
+	CreatedAt time.Time                  `json:"createdAt"`
 }
 
-// CreateURLsRequest defines the request used for creating URLs.
+func newURL(url internal.URL) URL {
+	return URL{
+		ID:                     url.ID,
+		URL:                    url.URL,
+		NormalizedURL:          url.NormalizedURL,
+		FinalURL:               url.FinalURL,
+		Redirects:              newRedirects(url.Redirects),
+		StatusCode:             url.StatusCode,
+		ContentType:            url.ContentType,
+		ContentLength:          url.ContentLength,
+		FetchDurationMs:        url.FetchDuration.Milliseconds(),
+		HTMLVersion:            url.HTMLVersion,
+		Doctype:                newDoctype(url.Doctype),
+		PageTitle:              url.PageTitle,
+		Headings:               newHeadings(url.Headings),
+		LinksCount:             url.LinksCount,
+		InaccessibleLinksCount: url.InaccessibleLinksCount,
+		InternalLinksCount:     url.InternalLinksCount,
+		ExternalLinksCount:     url.ExternalLinksCount,
+		NofollowLinksCount:     url.NofollowLinksCount,
+		SponsoredLinksCount:    url.SponsoredLinksCount,
+		UGCLinksCount:          url.UGCLinksCount,
+		UnsafeBlankLinksCount:  url.UnsafeBlankLinksCount,
+		HaveLoginForm:          url.HaveLoginForm,
+		Forms:                  newForms(url.Forms),
+		Sections:               url.Sections,
+		CreatedAt:              url.CreatedAt,
+	}
+}
+
+// Redirect is one hop of the redirect chain followed while fetching the page.
+type Redirect struct {
+	URL        string `json:"url"`
+	StatusCode int    `json:"statusCode"`
+}
+
+func newRedirects(redirects []internal.Redirect) []Redirect {
+	res := make([]Redirect, 0, len(redirects))
+
+	for _, redirect := range redirects {
+		res = append(res, Redirect{
+			URL:        redirect.URL,
+			StatusCode: redirect.StatusCode,
+		})
+	}
+
+	return res
+}
+
+// Doctype is the document type declaration of the page, Name is empty when the page has none.
+type Doctype struct {
+	Name     string `json:"name"`
+	PublicID string `json:"publicID"`
+	SystemID string `json:"systemID"`
+	Mode     string `json:"mode"`
+}
+
+func newDoctype(doctype internal.Doctype) Doctype {
+	return Doctype{
+		Name:     doctype.Name,
+		PublicID: doctype.PublicID,
+		SystemID: doctype.SystemID,
+		Mode:     string(doctype.Mode),
+	}
+}
+
+// Headings counts the heading elements of the page per level, Outline lists them in document order.
+type Headings struct {
+	H1      int       `json:"h1"`
+	H2      int       `json:"h2"`
+	H3      int       `json:"h3"`
+	H4      int       `json:"h4"`
+	H5      int       `json:"h5"`
+	H6      int       `json:"h6"`
+	Outline []Heading `json:"outline"`
+}
+
+// Heading is one heading element of the page.
+type Heading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+}
+
+func newHeadings(headings internal.Headings) Headings {
+	res := Headings{
+		H1:      headings.H1,
+		H2:      headings.H2,
+		H3:      headings.H3,
+		H4:      headings.H4,
+		H5:      headings.H5,
+		H6:      headings.H6,
+		Outline: make([]Heading, 0, len(headings.Outline)),
+	}
+
+	for _, heading := range headings.Outline {
+		res.Outline = append(res.Outline, Heading{
+			Level: heading.Level,
+			Text:  heading.Text,
+		})
+	}
+
+	return res
+}
+
+// Form is one form element of the page.
+type Form struct {
+	Kind   string      `json:"kind"`
+	Action string      `json:"action"`
+	Method string      `json:"method"`
+	Fields []FormField `json:"fields"`
+}
+
+// FormField is one input, select or textarea element of a form.
+type FormField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+}
+
+func newForms(forms []internal.Form) []Form {
+	res := make([]Form, 0, len(forms))
+
+	for _, form := range forms {
+		fields := make([]FormField, 0, len(form.Fields))
+		for _, field := range form.Fields {
+			fields = append(fields, FormField{
+				Name:     field.Name,
+				Type:     field.Type,
+				Required: field.Required,
+			})
+		}
+
+		res = append(res, Form{
+			Kind:   string(form.Kind),
+			Action: form.Action,
+			Method: form.Method,
+			Fields: fields,
+		})
+	}
+
+	return res
+}
+
+// CreateURLsRequest defines the request used for creating URLs, an analysis younger than MaxAge seconds is
+// reused when set, also by the job when Async is set.
 type CreateURLsRequest struct {
-	URL string `json:"url"`
+	URL    string `json:"url"`
+	Async  bool   `json:"async"`
+	MaxAge *int   `json:"maxAge"`
+}
+
+// maxAgeDuration converts the seconds of a request to a duration, values out of its range are clamped so
+// they are rejected when validated instead of wrapping around.
+func maxAgeDuration(seconds int) *time.Duration {
+	const limit = int64(math.MaxInt64 / time.Second)
+
+	var maxAge time.Duration
+
+	switch s := int64(seconds); {
+	case s > limit:
+		maxAge = math.MaxInt64
+	case s < -limit:
+		maxAge = math.MinInt64
+	default:
+		maxAge = time.Duration(s) * time.Second
+	}
+
+	return &maxAge
 }
 
 // CreateURLsResponse defines the response returned back after creating URLs.
@@ -71,26 +270,59 @@ func (u *URLHandler) search(w http.ResponseWriter, r *http.Request) {
 
 	defer r.Body.Close()
 
-	url, err := u.svc.Search(r.Context(), req.URL)
-	fmt.Println(err)
+	params := internal.SearchParams{
+		URL: req.URL,
+	}
+
+	if req.MaxAge != nil {
+		params.MaxAge = maxAgeDuration(*req.MaxAge)
+	}
+
+	if req.Async {
+		u.enqueue(w, r, params)
+		return
+	}
+
+	res, err := u.svc.Search(r.Context(), params)
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "search failed", err)
 		return
 	}
 
+	status := http.StatusCreated
+	w.Header().Set("X-Cache", "MISS")
+
+	if res.Cached {
+		status = http.StatusOK
+		w.Header().Set("X-Cache", "HIT")
+	}
+
 	renderResponse(w,
 		&CreateURLsResponse{
-			URL: URL{
-				ID:                     url.ID,
-				HTMLVersion:            url.HTMLVersion,
-				PageTitle:              url.PageTitle,
-				HeadingsCount:          url.HeadingsCount,
-				LinksCount:             url.LinksCount,
-				InaccessibleLinksCount: url.InaccessibleLinksCount,
-				HaveLoginForm:          url.HaveLoginForm,
-			},
+			URL: newURL(res.URL),
+		},
+		status)
+}
+
+// EnqueueURLsResponse defines the response returned back after enqueuing a URL analysis.
+type EnqueueURLsResponse struct {
+	Job Job `json:"job"`
+}
+
+func (u *URLHandler) enqueue(w http.ResponseWriter, r *http.Request, params internal.SearchParams) {
+	job, err := u.svc.Enqueue(r.Context(), params)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "enqueue failed", err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/jobs/%s", job.ID))
+
+	renderResponse(w,
+		&EnqueueURLsResponse{
+			Job: newJob(job),
 		},
-		http.StatusCreated)
+		http.StatusAccepted)
 }
 
 func (u *URLHandler) delete(w http.ResponseWriter, r *http.Request) {
@@ -120,15 +352,279 @@ func (u *URLHandler) find(w http.ResponseWriter, r *http.Request) {
 
 	renderResponse(w,
 		&ReadURLResponse{
-			URL: URL{
-				ID:                     url.ID,
-				HTMLVersion:            url.HTMLVersion,
-				PageTitle:              url.PageTitle,
-				HeadingsCount:          url.HeadingsCount,
-				LinksCount:             url.LinksCount,
-				InaccessibleLinksCount: url.InaccessibleLinksCount,
-				HaveLoginForm:          url.HaveLoginForm,
-			},
+			URL: newURL(url),
 		},
 		http.StatusOK)
 }
+
+// Link is an anchor of an analyzed page with the result of checking it.
+type Link struct {
+	Href        string `json:"href"`
+	ResolvedURL string `json:"resolvedURL"`
+	Text        string `json:"text"`
+	External    bool   `json:"external"`
+	StatusClass string `json:"statusClass"`
+	StatusCode  int    `json:"statusCode"`
+	Error       string `json:"error,omitempty"`
+	LatencyMs   int64  `json:"latencyMs"`
+}
+
+func newLink(link internal.Link) Link {
+	return Link{
+		Href:        link.Href,
+		ResolvedURL: link.ResolvedURL,
+		Text:        link.Text,
+		External:    link.External,
+		StatusClass: string(link.StatusClass()),
+		StatusCode:  link.StatusCode,
+		Error:       link.Error,
+		LatencyMs:   link.Latency.Milliseconds(),
+	}
+}
+
+// ReadURLLinksResponse defines the response returned back after searching the links of one URL.
+type ReadURLLinksResponse struct {
+	Links []Link `json:"links"`
+}
+
+func (u *URLHandler) findLinks(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	links, err := u.svc.FindLinks(r.Context(), id, internal.LinkStatusClass(r.URL.Query().Get("status")))
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find links failed", err)
+		return
+	}
+
+	resp := ReadURLLinksResponse{
+		Links: make([]Link, 0, len(links)),
+	}
+
+	for _, link := range links {
+		resp.Links = append(resp.Links, newLink(link))
+	}
+
+	renderResponse(w, &resp, http.StatusOK)
+}
+
+// AccessibilityIssue is an element of an analyzed page failing an accessibility rule.
+type AccessibilityIssue struct {
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Location string `json:"location"`
+	Message  string `json:"message"`
+}
+
+// ReadURLAccessibilityResponse defines the response returned back after searching the accessibility issues
+// of one URL.
+type ReadURLAccessibilityResponse struct {
+	Issues []AccessibilityIssue `json:"issues"`
+}
+
+func (u *URLHandler) findAccessibilityIssues(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	issues, err := u.svc.FindAccessibilityIssues(r.Context(), id, internal.AccessibilityIssuesParams{
+		Rule:     internal.AccessibilityRule(r.URL.Query().Get("rule")),
+		Severity: internal.AccessibilitySeverity(r.URL.Query().Get("severity")),
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find accessibility issues failed", err)
+		return
+	}
+
+	resp := ReadURLAccessibilityResponse{
+		Issues: make([]AccessibilityIssue, 0, len(issues)),
+	}
+
+	for _, issue := range issues {
+		resp.Issues = append(resp.Issues, AccessibilityIssue{
+			Rule:     string(issue.Rule),
+			Severity: string(issue.Severity),
+			Location: issue.Location,
+			Message:  issue.Message,
+		})
+	}
+
+	renderResponse(w, &resp, http.StatusOK)
+}
+
+// ReadURLHistoryResponse defines the response returned back after searching the analyses of one URL.
+type ReadURLHistoryResponse struct {
+	URLs []URL `json:"URLs"`
+}
+
+func (u *URLHandler) history(w http.ResponseWriter, r *http.Request) {
+	URLs, err := u.svc.History(r.Context(), r.URL.Query().Get("url"))
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "history failed", err)
+		return
+	}
+
+	resp := ReadURLHistoryResponse{
+		URLs: make([]URL, 0, len(URLs)),
+	}
+
+	for _, url := range URLs {
+		resp.URLs = append(resp.URLs, newURL(url))
+	}
+
+	renderResponse(w, &resp, http.StatusOK)
+}
+
+// URLDiff lists what changed between two analyses of the same page.
+type URLDiff struct {
+	From           URL           `json:"from"`
+	To             URL           `json:"to"`
+	Changes        []FieldChange `json:"changes"`
+	NewBrokenLinks []Link        `json:"newBrokenLinks"`
+	FixedLinks     []Link        `json:"fixedLinks"`
+}
+
+// FieldChange is the value of one field of URL in both analyses.
+type FieldChange struct {
+	Field string      `json:"field"`
+	From  interface{} `json:"from"`
+	To    interface{} `json:"to"`
+}
+
+func newURLDiff(diff internal.URLDiff) URLDiff {
+	res := URLDiff{
+		From:           newURL(diff.From),
+		To:             newURL(diff.To),
+		Changes:        make([]FieldChange, 0, len(diff.Changes)),
+		NewBrokenLinks: make([]Link, 0, len(diff.NewBrokenLinks)),
+		FixedLinks:     make([]Link, 0, len(diff.FixedLinks)),
+	}
+
+	for _, change := range diff.Changes {
+		res.Changes = append(res.Changes, FieldChange{
+			Field: change.Field,
+			From:  newFieldValue(change.From),
+			To:    newFieldValue(change.To),
+		})
+	}
+
+	for _, link := range diff.NewBrokenLinks {
+		res.NewBrokenLinks = append(res.NewBrokenLinks, newLink(link))
+	}
+
+	for _, link := range diff.FixedLinks {
+		res.FixedLinks = append(res.FixedLinks, newLink(link))
+	}
+
+	return res
+}
+
+// newFieldValue renders the values of the structured fields like the URL does
+func newFieldValue(val interface{}) interface{} {
+	switch val := val.(type) {
+	case []internal.Redirect:
+		return newRedirects(val)
+	case internal.Headings:
+		return newHeadings(val)
+	case []internal.Form:
+		return newForms(val)
+	}
+
+	return val
+}
+
+// ReadURLDiffResponse defines the response returned back after comparing two analyses.
+type ReadURLDiffResponse struct {
+	Diff URLDiff `json:"diff"`
+}
+
+func (u *URLHandler) diff(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+
+	diff, err := u.svc.Diff(r.Context(), vars["id"], vars["otherID"])
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "diff failed", err)
+		return
+	}
+
+	renderResponse(w,
+		&ReadURLDiffResponse{
+			Diff: newURLDiff(diff),
+		},
+		http.StatusOK)
+}
+
+// ListURLsResponse defines the response returned back after listing URLs.
+type ListURLsResponse struct {
+	URLs       []URL  `json:"URLs"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
+
+func (u *URLHandler) list(w http.ResponseWriter, r *http.Request) {
+	params, err := newListURLsParams(r.URL.Query())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+		return
+	}
+
+	res, err := u.svc.List(r.Context(), params)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "list failed", err)
+		return
+	}
+
+	resp := ListURLsResponse{
+		URLs:       make([]URL, 0, len(res.URLs)),
+		NextCursor: res.NextCursor,
+	}
+
+	for _, url := range res.URLs {
+		resp.URLs = append(resp.URLs, newURL(url))
+	}
+
+	renderResponse(w, &resp, http.StatusOK)
+}
+
+func newListURLsParams(query url.Values) (internal.ListURLsParams, error) {
+	params := internal.ListURLsParams{
+		Host:        query.Get("host"),
+		HTMLVersion: query.Get("HTMLVersion"),
+		Sort:        internal.URLSort(query.Get("sort")),
+		Cursor:      query.Get("cursor"),
+	}
+
+	if val := query.Get("haveLoginForm"); val != "" {
+		haveLoginForm, err := strconv.ParseBool(val)
+		if err != nil {
+			return internal.ListURLsParams{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid haveLoginForm")
+		}
+
+		params.HaveLoginForm = &haveLoginForm
+	}
+
+	if val := query.Get("createdFrom"); val != "" {
+		createdFrom, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			return internal.ListURLsParams{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid createdFrom")
+		}
+
+		params.CreatedFrom = createdFrom
+	}
+
+	if val := query.Get("createdTo"); val != "" {
+		createdTo, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			return internal.ListURLsParams{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid createdTo")
+		}
+
+		params.CreatedTo = createdTo
+	}
+
+	if val := query.Get("limit"); val != "" {
+		limit, err := strconv.Atoi(val)
+		if err != nil {
+			return internal.ListURLsParams{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid limit")
+		}
+
+		params.Limit = limit
+	}
+
+	return params, nil
+}
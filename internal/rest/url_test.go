@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -107,15 +108,24 @@ func TestURLs_Search(t *testing.T) {
 			"OK: 201",
 			func(s *resttesting.FakeURLService) {
 				s.SearchReturns(
-					internal.URL{
+					internal.SearchResult{URL: internal.URL{
 						ID:                     "1-2-3",
+						URL:                    "https://example.com",
+						NormalizedURL:          "https://example.com/",
+						FinalURL:               "https://www.example.com/",
+						Redirects:              []internal.Redirect{{URL: "https://example.com", StatusCode: 301}},
+						StatusCode:             200,
+						ContentType:            "text/html; charset=utf-8",
+						ContentLength:          1024,
+						FetchDuration:          150 * time.Millisecond,
 						HTMLVersion:            "22",
 						PageTitle:              "url.PageTitle",
-						HeadingsCount:          "url.HeadingsCount",
+						Headings:               internal.Headings{H1: 1, H3: 1, Outline: []internal.Heading{{Level: 1, Text: "Title"}, {Level: 3, Text: "Sub"}}},
 						LinksCount:             2,
 						InaccessibleLinksCount: 3,
 						HaveLoginForm:          true,
-					},
+						CreatedAt:              time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					}},
 					nil)
 			},
 			func() []byte {
@@ -130,17 +140,124 @@ func TestURLs_Search(t *testing.T) {
 				&rest.CreateURLsResponse{
 					URL: rest.URL{
 						ID:                     "1-2-3",
+						URL:                    "https://example.com",
+						NormalizedURL:          "https://example.com/",
+						FinalURL:               "https://www.example.com/",
+						Redirects:              []rest.Redirect{{URL: "https://example.com", StatusCode: 301}},
+						StatusCode:             200,
+						ContentType:            "text/html; charset=utf-8",
+						ContentLength:          1024,
+						FetchDurationMs:        150,
 						HTMLVersion:            "22",
 						PageTitle:              "url.PageTitle",
-						HeadingsCount:          "url.HeadingsCount",
+						Headings:               rest.Headings{H1: 1, H3: 1, Outline: []rest.Heading{{Level: 1, Text: "Title"}, {Level: 3, Text: "Sub"}}},
 						LinksCount:             2,
 						InaccessibleLinksCount: 3,
 						HaveLoginForm:          true,
+						Forms:                  []rest.Form{},
+						CreatedAt:              time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
 					},
 				},
 				&rest.CreateURLsResponse{},
 			},
 		},
+		{
+			"OK: 200 cached",
+			func(s *resttesting.FakeURLService) {
+				s.SearchReturns(
+					internal.SearchResult{
+						URL: internal.URL{
+							ID:         "1-2-3",
+							URL:        "https://example.com",
+							FinalURL:   "https://example.com",
+							StatusCode: 200,
+							CreatedAt:  time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						},
+						Cached: true,
+					},
+					nil)
+			},
+			func() []byte {
+				maxAge := 60
+
+				b, _ := json.Marshal(&rest.CreateURLsRequest{
+					URL:    "https://example.com",
+					MaxAge: &maxAge,
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusOK,
+				&rest.CreateURLsResponse{
+					URL: rest.URL{
+						ID:         "1-2-3",
+						URL:        "https://example.com",
+						FinalURL:   "https://example.com",
+						Redirects:  []rest.Redirect{},
+						StatusCode: 200,
+						Headings:   rest.Headings{Outline: []rest.Heading{}},
+						Forms:      []rest.Form{},
+						CreatedAt:  time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				&rest.CreateURLsResponse{},
+			},
+		},
+		{
+			"OK: 202",
+			func(s *resttesting.FakeURLService) {
+				s.EnqueueReturns(
+					internal.Job{
+						ID:        "1-2-3",
+						URL:       "https://example.com",
+						Status:    internal.JobStatusQueued,
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+					nil)
+			},
+			func() []byte {
+				b, _ := json.Marshal(&rest.CreateURLsRequest{
+					URL:   "https://example.com",
+					Async: true,
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusAccepted,
+				&rest.EnqueueURLsResponse{
+					Job: rest.Job{
+						ID:        "1-2-3",
+						URL:       "https://example.com",
+						Status:    "queued",
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				&rest.EnqueueURLsResponse{},
+			},
+		},
+		{
+			"ERR: 400 async",
+			func(s *resttesting.FakeURLService) {
+				s.EnqueueReturns(internal.Job{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "URL is required"))
+			},
+			[]byte(`{"async":true}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "URL is required",
+					Error:  "enqueue failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
 		{
 			"ERR: 400",
 			func(*resttesting.FakeURLService) {},
@@ -148,7 +265,69 @@ func TestURLs_Search(t *testing.T) {
 			output{
 				http.StatusBadRequest,
 				&rest.ErrorResponse{
-					Error: "invalid request",
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "json decoder: unexpected EOF",
+					Error:  "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 502",
+			func(s *resttesting.FakeURLService) {
+				s.SearchReturns(internal.SearchResult{},
+					internal.NewUpstreamStatusErrorf(http.StatusNotFound, "upstream responded with status 404"))
+			},
+			[]byte(`{"url":"https://example.com/missing"}`),
+			output{
+				http.StatusBadGateway,
+				&rest.ErrorResponse{
+					Type:           "/problems/upstream-status",
+					Title:          "Unexpected upstream status",
+					Status:         http.StatusBadGateway,
+					Detail:         "upstream responded with status 404",
+					UpstreamStatus: http.StatusNotFound,
+					Error:          "search failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 502 too many redirects",
+			func(s *resttesting.FakeURLService) {
+				s.SearchReturns(internal.SearchResult{},
+					internal.NewErrorf(internal.ErrorCodeUpstreamUnreachable, "stopped after 10 redirects"))
+			},
+			[]byte(`{"url":"https://example.com/loop"}`),
+			output{
+				http.StatusBadGateway,
+				&rest.ErrorResponse{
+					Type:   "/problems/upstream-unreachable",
+					Title:  "Upstream unreachable",
+					Status: http.StatusBadGateway,
+					Detail: "stopped after 10 redirects",
+					Error:  "search failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 422",
+			func(s *resttesting.FakeURLService) {
+				s.SearchReturns(internal.SearchResult{},
+					internal.NewErrorf(internal.ErrorCodeForbiddenTarget, "address 127.0.0.1 is not allowed"))
+			},
+			[]byte(`{"url":"http://localhost:5432"}`),
+			output{
+				http.StatusUnprocessableEntity,
+				&rest.ErrorResponse{
+					Type:   "/problems/forbidden-target",
+					Title:  "Target address not allowed",
+					Status: http.StatusUnprocessableEntity,
+					Detail: "address 127.0.0.1 is not allowed",
+					Error:  "search failed",
 				},
 				&rest.ErrorResponse{},
 			},
@@ -156,14 +335,17 @@ func TestURLs_Search(t *testing.T) {
 		{
 			"ERR: 500",
 			func(s *resttesting.FakeURLService) {
-				s.SearchReturns(internal.URL{},
+				s.SearchReturns(internal.SearchResult{},
 					errors.New("service error"))
 			},
 			[]byte(`{}`),
 			output{
 				http.StatusInternalServerError,
 				&rest.ErrorResponse{
-					Error: "internal error",
+					Type:   "/problems/internal",
+					Title:  "Internal error",
+					Status: http.StatusInternalServerError,
+					Error:  "internal error",
 				},
 				&rest.ErrorResponse{},
 			},
@@ -190,10 +372,65 @@ func TestURLs_Search(t *testing.T) {
 			if tt.output.expectedStatus != res.StatusCode {
 				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
 			}
+
+			if _, ok := tt.output.expected.(*rest.ErrorResponse); ok && res.Header.Get("Content-Type") != "application/problem+json" {
+				t.Fatalf("expected problem content type, actual %s", res.Header.Get("Content-Type"))
+			}
+
+			if cache := res.Header.Get("X-Cache"); res.StatusCode == http.StatusOK && cache != "HIT" ||
+				res.StatusCode == http.StatusCreated && cache != "MISS" {
+				t.Fatalf("unexpected X-Cache %q for code %d", cache, res.StatusCode)
+			}
 		})
 	}
 }
 
+func TestURLs_Search_MaxAgeOverflow(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeURLService{}
+
+	rest.NewURLHandler(svc).Register(router)
+
+	_ = doRequest(router,
+		httptest.NewRequest(http.MethodPost, "/URLs",
+			bytes.NewReader([]byte(`{"url":"https://example.com","maxAge":9223372036854775807}`))))
+
+	if svc.SearchCallCount() != 1 {
+		t.Fatalf("expected one search, actual %d", svc.SearchCallCount())
+	}
+
+	_, params := svc.SearchArgsForCall(0)
+
+	if params.MaxAge == nil || *params.MaxAge <= internal.MaxSearchMaxAge {
+		t.Fatalf("expected maxAge above the limit, actual %v", params.MaxAge)
+	}
+}
+
+func TestURLs_Search_AsyncMaxAge(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeURLService{}
+
+	rest.NewURLHandler(svc).Register(router)
+
+	res := doRequest(router,
+		httptest.NewRequest(http.MethodPost, "/URLs",
+			bytes.NewReader([]byte(`{"url":"https://example.com","async":true,"maxAge":60}`))))
+
+	if res.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected code %d, actual %d", http.StatusAccepted, res.StatusCode)
+	}
+
+	_, params := svc.EnqueueArgsForCall(0)
+
+	if params.MaxAge == nil || *params.MaxAge != time.Minute {
+		t.Fatalf("expected maxAge %s, actual %v", time.Minute, params.MaxAge)
+	}
+}
+
 func TestURLs_Find(t *testing.T) {
 	t.Parallel()
 
@@ -214,12 +451,27 @@ func TestURLs_Find(t *testing.T) {
 				s.FindReturns(
 					internal.URL{
 						ID:                     "a-b-c",
+						URL:                    "https://example.com",
+						NormalizedURL:          "https://example.com/",
+						FinalURL:               "https://www.example.com/",
+						Redirects:              []internal.Redirect{{URL: "https://example.com", StatusCode: 301}},
+						StatusCode:             200,
+						ContentType:            "text/html; charset=utf-8",
+						ContentLength:          1024,
+						FetchDuration:          150 * time.Millisecond,
 						HTMLVersion:            "22",
 						PageTitle:              "url.PageTitle",
-						HeadingsCount:          "url.HeadingsCount",
+						Headings:               internal.Headings{H1: 1, H3: 1, Outline: []internal.Heading{{Level: 1, Text: "Title"}, {Level: 3, Text: "Sub"}}},
 						LinksCount:             2,
 						InaccessibleLinksCount: 3,
 						HaveLoginForm:          true,
+						Forms: []internal.Form{
+							{Kind: internal.FormKindLogin, Action: "https://example.com/session", Method: "POST", Fields: []internal.FormField{{Name: "email", Type: "email", Required: true}, {Name: "password", Type: "password"}}},
+						},
+						Sections: map[string]json.RawMessage{
+							"metadata": json.RawMessage(`{"description":"Example","descriptionLength":7,"canonicalSelf":true}`),
+						},
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
 					},
 					nil)
 			},
@@ -228,12 +480,27 @@ func TestURLs_Find(t *testing.T) {
 				&rest.ReadURLResponse{
 					URL: rest.URL{
 						ID:                     "a-b-c",
+						URL:                    "https://example.com",
+						NormalizedURL:          "https://example.com/",
+						FinalURL:               "https://www.example.com/",
+						Redirects:              []rest.Redirect{{URL: "https://example.com", StatusCode: 301}},
+						StatusCode:             200,
+						ContentType:            "text/html; charset=utf-8",
+						ContentLength:          1024,
+						FetchDurationMs:        150,
 						HTMLVersion:            "22",
 						PageTitle:              "url.PageTitle",
-						HeadingsCount:          "url.HeadingsCount",
+						Headings:               rest.Headings{H1: 1, H3: 1, Outline: []rest.Heading{{Level: 1, Text: "Title"}, {Level: 3, Text: "Sub"}}},
 						LinksCount:             2,
 						InaccessibleLinksCount: 3,
 						HaveLoginForm:          true,
+						Forms: []rest.Form{
+							{Kind: "login", Action: "https://example.com/session", Method: "POST", Fields: []rest.FormField{{Name: "email", Type: "email", Required: true}, {Name: "password", Type: "password"}}},
+						},
+						Sections: map[string]json.RawMessage{
+							"metadata": json.RawMessage(`{"description":"Example","descriptionLength":7,"canonicalSelf":true}`),
+						},
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
 					},
 				},
 				&rest.ReadURLResponse{},
@@ -248,7 +515,11 @@ func TestURLs_Find(t *testing.T) {
 			output{
 				http.StatusNotFound,
 				&rest.ErrorResponse{
-					Error: "find failed",
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "not found",
+					Error:  "find failed",
 				},
 				&rest.ErrorResponse{},
 			},
@@ -262,7 +533,10 @@ func TestURLs_Find(t *testing.T) {
 			output{
 				http.StatusInternalServerError,
 				&rest.ErrorResponse{
-					Error: "internal error",
+					Type:   "/problems/internal",
+					Title:  "Internal error",
+					Status: http.StatusInternalServerError,
+					Error:  "internal error",
 				},
 				&rest.ErrorResponse{},
 			},
@@ -293,6 +567,630 @@ func TestURLs_Find(t *testing.T) {
 	}
 }
 
+func TestURLs_FindLinks(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeURLService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeURLService) {
+				s.FindLinksReturns(
+					[]internal.Link{
+						{
+							Href:        "/missing",
+							ResolvedURL: "https://example.com/missing",
+							Text:        "Missing",
+							StatusCode:  404,
+							Latency:     25 * time.Millisecond,
+						},
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadURLLinksResponse{
+					Links: []rest.Link{
+						{
+							Href:        "/missing",
+							ResolvedURL: "https://example.com/missing",
+							Text:        "Missing",
+							StatusClass: "4xx",
+							StatusCode:  404,
+							LatencyMs:   25,
+						},
+					},
+				},
+				&rest.ReadURLLinksResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(s *resttesting.FakeURLService) {
+				s.FindLinksReturns(nil,
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid status"))
+			},
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "invalid status",
+					Error:  "find links failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeURLService) {
+				s.FindLinksReturns(nil,
+					internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "not found",
+					Error:  "find links failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeURLService{}
+			tt.setup(svc)
+
+			rest.NewURLHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/URLs/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/links?status=4xx", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if _, _, class := svc.FindLinksArgsForCall(0); class != internal.LinkStatusClass4xx {
+				t.Fatalf("expected status class 4xx, got %s", class)
+			}
+		})
+	}
+}
+
+func TestURLs_FindAccessibilityIssues(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeURLService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeURLService) {
+				s.FindAccessibilityIssuesReturns(
+					[]internal.AccessibilityIssue{
+						{
+							Rule:     internal.AccessibilityRuleImageAlt,
+							Severity: internal.AccessibilitySeverityCritical,
+							Location: "html > body > img",
+							Message:  "image has no alt attribute",
+						},
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadURLAccessibilityResponse{
+					Issues: []rest.AccessibilityIssue{
+						{
+							Rule:     "image-alt",
+							Severity: "critical",
+							Location: "html > body > img",
+							Message:  "image has no alt attribute",
+						},
+					},
+				},
+				&rest.ReadURLAccessibilityResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(s *resttesting.FakeURLService) {
+				s.FindAccessibilityIssuesReturns(nil,
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid severity"))
+			},
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "invalid severity",
+					Error:  "find accessibility issues failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeURLService) {
+				s.FindAccessibilityIssuesReturns(nil,
+					internal.NewErrorf(internal.ErrorCodeNotFound, "accessibility not analyzed"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "accessibility not analyzed",
+					Error:  "find accessibility issues failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeURLService{}
+			tt.setup(svc)
+
+			rest.NewURLHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/URLs/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/accessibility?severity=critical&rule=image-alt", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			expected := internal.AccessibilityIssuesParams{
+				Rule:     internal.AccessibilityRuleImageAlt,
+				Severity: internal.AccessibilitySeverityCritical,
+			}
+
+			if _, _, params := svc.FindAccessibilityIssuesArgsForCall(0); params != expected {
+				t.Fatalf("expected params %+v, got %+v", expected, params)
+			}
+		})
+	}
+}
+
+func TestURLs_History(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeURLService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeURLService) {
+				s.HistoryReturns(
+					[]internal.URL{
+						{
+							ID:            "1-2-3",
+							URL:           "example.com",
+							NormalizedURL: "https://example.com/",
+							PageTitle:     "Before",
+							CreatedAt:     time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC),
+						},
+						{
+							ID:            "4-5-6",
+							URL:           "https://Example.com",
+							NormalizedURL: "https://example.com/",
+							PageTitle:     "After",
+							CreatedAt:     time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						},
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadURLHistoryResponse{
+					URLs: []rest.URL{
+						{
+							ID:            "1-2-3",
+							URL:           "example.com",
+							NormalizedURL: "https://example.com/",
+							Redirects:     []rest.Redirect{},
+							PageTitle:     "Before",
+							Headings:      rest.Headings{Outline: []rest.Heading{}},
+							Forms:         []rest.Form{},
+							CreatedAt:     time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC),
+						},
+						{
+							ID:            "4-5-6",
+							URL:           "https://Example.com",
+							NormalizedURL: "https://example.com/",
+							Redirects:     []rest.Redirect{},
+							PageTitle:     "After",
+							Headings:      rest.Headings{Outline: []rest.Heading{}},
+							Forms:         []rest.Form{},
+							CreatedAt:     time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+				&rest.ReadURLHistoryResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(s *resttesting.FakeURLService) {
+				s.HistoryReturns(nil,
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "URL is required"))
+			},
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "URL is required",
+					Error:  "history failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeURLService{}
+			tt.setup(svc)
+
+			rest.NewURLHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/URLs/history?url=example.com", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if _, URL := svc.HistoryArgsForCall(0); URL != "example.com" {
+				t.Fatalf("expected URL example.com, got %s", URL)
+			}
+		})
+	}
+}
+
+func TestURLs_Diff(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeURLService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeURLService) {
+				s.DiffReturns(
+					internal.URLDiff{
+						From: internal.URL{ID: "1-2-3", PageTitle: "Before"},
+						To:   internal.URL{ID: "4-5-6", PageTitle: "After", HaveLoginForm: true},
+						Changes: []internal.FieldChange{
+							{Field: "pageTitle", From: "Before", To: "After"},
+							{Field: "haveLoginForm", From: false, To: true},
+						},
+						NewBrokenLinks: []internal.Link{
+							{Href: "/missing", ResolvedURL: "https://example.com/missing", StatusCode: 404},
+						},
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadURLDiffResponse{
+					Diff: rest.URLDiff{
+						From: rest.URL{
+							ID:        "1-2-3",
+							Redirects: []rest.Redirect{},
+							PageTitle: "Before",
+							Headings:  rest.Headings{Outline: []rest.Heading{}},
+							Forms:     []rest.Form{},
+						},
+						To: rest.URL{
+							ID:            "4-5-6",
+							Redirects:     []rest.Redirect{},
+							PageTitle:     "After",
+							Headings:      rest.Headings{Outline: []rest.Heading{}},
+							HaveLoginForm: true,
+							Forms:         []rest.Form{},
+						},
+						Changes: []rest.FieldChange{
+							{Field: "pageTitle", From: "Before", To: "After"},
+							{Field: "haveLoginForm", From: false, To: true},
+						},
+						NewBrokenLinks: []rest.Link{
+							{Href: "/missing", ResolvedURL: "https://example.com/missing", StatusClass: "4xx", StatusCode: 404},
+						},
+						FixedLinks: []rest.Link{},
+					},
+				},
+				&rest.ReadURLDiffResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(s *resttesting.FakeURLService) {
+				s.DiffReturns(internal.URLDiff{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "analyses are not of the same URL"))
+			},
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "analyses are not of the same URL",
+					Error:  "diff failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeURLService) {
+				s.DiffReturns(internal.URLDiff{},
+					internal.NewErrorf(internal.ErrorCodeNotFound, "URL not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "URL not found",
+					Error:  "diff failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeURLService{}
+			tt.setup(svc)
+
+			rest.NewURLHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet,
+					"/URLs/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/diff/ffffffff-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if _, fromID, toID := svc.DiffArgsForCall(0); fromID != "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee" ||
+				toID != "ffffffff-bbbb-cccc-dddd-eeeeeeeeeeee" {
+				t.Fatalf("unexpected ids %s, %s", fromID, toID)
+			}
+		})
+	}
+}
+
+func TestURLs_List(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeURLService)
+		query  string
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeURLService) {
+				s.ListReturns(
+					internal.ListURLsResult{
+						URLs: []internal.URL{
+							{
+								ID:                     "a-b-c",
+								URL:                    "https://example.com",
+								HTMLVersion:            "HTML 5",
+								PageTitle:              "url.PageTitle",
+								Headings:               internal.Headings{H2: 1, Outline: []internal.Heading{{Level: 2, Text: "Section"}}},
+								LinksCount:             2,
+								InaccessibleLinksCount: 1,
+								CreatedAt:              time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+							},
+						},
+						NextCursor: "next",
+					},
+					nil)
+			},
+			"?host=example.com&haveLoginForm=false&sort=inaccessibleLinksCount&limit=1",
+			output{
+				http.StatusOK,
+				&rest.ListURLsResponse{
+					URLs: []rest.URL{
+						{
+							ID:                     "a-b-c",
+							URL:                    "https://example.com",
+							Redirects:              []rest.Redirect{},
+							HTMLVersion:            "HTML 5",
+							PageTitle:              "url.PageTitle",
+							Headings:               rest.Headings{H2: 1, Outline: []rest.Heading{{Level: 2, Text: "Section"}}},
+							LinksCount:             2,
+							InaccessibleLinksCount: 1,
+							Forms:                  []rest.Form{},
+							CreatedAt:              time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						},
+					},
+					NextCursor: "next",
+				},
+				&rest.ListURLsResponse{},
+			},
+		},
+		{
+			"ERR: 400 limit",
+			func(*resttesting.FakeURLService) {},
+			"?limit=many",
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: `invalid limit: strconv.Atoi: parsing "many": invalid syntax`,
+					Error:  "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 createdFrom",
+			func(*resttesting.FakeURLService) {},
+			"?createdFrom=yesterday",
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: `invalid createdFrom: parsing time "yesterday" as "2006-01-02T15:04:05Z07:00": cannot parse "yesterday" as "2006"`,
+					Error:  "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 validation",
+			func(s *resttesting.FakeURLService) {
+				s.ListReturns(internal.ListURLsResult{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unsupported sort"))
+			},
+			"?sort=title",
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "unsupported sort",
+					Error:  "list failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeURLService) {
+				s.ListReturns(internal.ListURLsResult{},
+					errors.New("service error"))
+			},
+			"",
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Type:   "/problems/internal",
+					Title:  "Internal error",
+					Status: http.StatusInternalServerError,
+					Error:  "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeURLService{}
+			tt.setup(svc)
+
+			rest.NewURLHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/URLs"+tt.query, nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
 type test struct {
 	expected interface{}
 	target   interface{}
@@ -0,0 +1,164 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+//go:generate counterfeiter -o resttesting/watch_service.gen.go . WatchService
+
+// WatchService
+type WatchService interface {
+	Create(ctx context.Context, URL, schedule string) (internal.Watch, error)
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.Watch, error)
+	List(ctx context.Context) ([]internal.Watch, error)
+}
+
+// WatchHandler
+type WatchHandler struct {
+	svc WatchService
+}
+
+// NewWatchHandler
+func NewWatchHandler(svc WatchService) *WatchHandler {
+	return &WatchHandler{
+		svc: svc,
+	}
+}
+
+// Register connects the handlers to the router.
+func (h *WatchHandler) Register(r *mux.Router) {
+	r.HandleFunc("/watches", h.create).Methods(http.MethodPost)
+	r.HandleFunc("/watches", h.list).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/watches/{id:%s}", uuidRegEx), h.find).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/watches/{id:%s}", uuidRegEx), h.delete).Methods(http.MethodDelete)
+}
+
+// Watch re-analyzes a URL on a schedule, the last fields describe the job enqueued by its latest run.
+type Watch struct {
+	ID         string     `json:"id"`
+	URL        string     `json:"url"`
+	Schedule   string     `json:"schedule"`
+	NextRunAt  time.Time  `json:"nextRunAt"`
+	LastRunAt  *time.Time `json:"lastRunAt,omitempty"`
+	LastJobID  string     `json:"lastJobId,omitempty"`
+	LastStatus string     `json:"lastStatus,omitempty"`
+	LastURLID  string     `json:"lastURLId,omitempty"`
+	LastError  string     `json:"lastError,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+func newWatch(watch internal.Watch) Watch {
+	res := Watch{
+		ID:         watch.ID,
+		URL:        watch.URL,
+		Schedule:   watch.Schedule,
+		NextRunAt:  watch.NextRunAt,
+		LastJobID:  watch.LastJobID,
+		LastStatus: string(watch.LastStatus),
+		LastURLID:  watch.LastURLID,
+		LastError:  watch.LastError,
+		CreatedAt:  watch.CreatedAt,
+		UpdatedAt:  watch.UpdatedAt,
+	}
+
+	if !watch.LastRunAt.IsZero() {
+		res.LastRunAt = &watch.LastRunAt
+	}
+
+	return res
+}
+
+// CreateWatchesRequest defines the request used for creating watches, Schedule is either an interval like
+// "1h" or "@every 30m", or a cron expression evaluated in UTC.
+type CreateWatchesRequest struct {
+	URL      string `json:"url"`
+	Schedule string `json:"schedule"`
+}
+
+// WatchResponse defines the response returned back after creating or searching one watch.
+type WatchResponse struct {
+	Watch Watch `json:"watch"`
+}
+
+func (h *WatchHandler) create(w http.ResponseWriter, r *http.Request) {
+	var req CreateWatchesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request",
+			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json decoder"))
+		return
+	}
+
+	defer r.Body.Close()
+
+	watch, err := h.svc.Create(r.Context(), req.URL, req.Schedule)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create failed", err)
+		return
+	}
+
+	renderResponse(w,
+		&WatchResponse{
+			Watch: newWatch(watch),
+		},
+		http.StatusCreated)
+}
+
+func (h *WatchHandler) delete(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	if err := h.svc.Delete(r.Context(), id); err != nil {
+		renderErrorResponse(r.Context(), w, "delete failed", err)
+		return
+	}
+
+	renderResponse(w, struct{}{}, http.StatusOK)
+}
+
+func (h *WatchHandler) find(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	watch, err := h.svc.Find(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find failed", err)
+		return
+	}
+
+	renderResponse(w,
+		&WatchResponse{
+			Watch: newWatch(watch),
+		},
+		http.StatusOK)
+}
+
+// ListWatchesResponse defines the response returned back after listing watches.
+type ListWatchesResponse struct {
+	Watches []Watch `json:"watches"`
+}
+
+func (h *WatchHandler) list(w http.ResponseWriter, r *http.Request) {
+	watches, err := h.svc.List(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "list failed", err)
+		return
+	}
+
+	resp := ListWatchesResponse{
+		Watches: make([]Watch, 0, len(watches)),
+	}
+
+	for _, watch := range watches {
+		resp.Watches = append(resp.Watches, newWatch(watch))
+	}
+
+	renderResponse(w, &resp, http.StatusOK)
+}
@@ -0,0 +1,380 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/rest"
+	"github.com/Oguzyildirim/url-info/internal/rest/resttesting"
+)
+
+func TestWatches_Create(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeWatchService)
+		input  []byte
+		output output
+	}{
+		{
+			"OK: 201",
+			func(s *resttesting.FakeWatchService) {
+				s.CreateReturns(
+					internal.Watch{
+						ID:        "a-b-c",
+						URL:       "https://example.com",
+						Schedule:  "@every 1h",
+						NextRunAt: time.Date(2021, 7, 2, 11, 0, 0, 0, time.UTC),
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+					nil)
+			},
+			func() []byte {
+				b, _ := json.Marshal(&rest.CreateWatchesRequest{
+					URL:      "https://example.com",
+					Schedule: "@every 1h",
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusCreated,
+				&rest.WatchResponse{
+					Watch: rest.Watch{
+						ID:        "a-b-c",
+						URL:       "https://example.com",
+						Schedule:  "@every 1h",
+						NextRunAt: time.Date(2021, 7, 2, 11, 0, 0, 0, time.UTC),
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				&rest.WatchResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(*resttesting.FakeWatchService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "json decoder: unexpected EOF",
+					Error:  "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 schedule",
+			func(s *resttesting.FakeWatchService) {
+				s.CreateReturns(internal.Watch{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "cron schedule must have 5 fields"))
+			},
+			[]byte(`{"url":"https://example.com","schedule":"* *"}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "cron schedule must have 5 fields",
+					Error:  "create failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeWatchService{}
+			tt.setup(svc)
+
+			rest.NewWatchHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/watches", bytes.NewReader(tt.input)))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestWatches_Find(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeWatchService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeWatchService) {
+				s.FindReturns(
+					internal.Watch{
+						ID:         "a-b-c",
+						URL:        "https://example.com",
+						Schedule:   "0 * * * *",
+						NextRunAt:  time.Date(2021, 7, 2, 11, 0, 0, 0, time.UTC),
+						LastRunAt:  time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						LastJobID:  "d-e-f",
+						LastStatus: internal.JobStatusFailed,
+						LastError:  "upstream responded with status 503",
+						CreatedAt:  time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt:  time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.WatchResponse{
+					Watch: rest.Watch{
+						ID:         "a-b-c",
+						URL:        "https://example.com",
+						Schedule:   "0 * * * *",
+						NextRunAt:  time.Date(2021, 7, 2, 11, 0, 0, 0, time.UTC),
+						LastRunAt:  func() *time.Time { t := time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC); return &t }(),
+						LastJobID:  "d-e-f",
+						LastStatus: "failed",
+						LastError:  "upstream responded with status 503",
+						CreatedAt:  time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC),
+						UpdatedAt:  time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				&rest.WatchResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeWatchService) {
+				s.FindReturns(internal.Watch{},
+					internal.NewErrorf(internal.ErrorCodeNotFound, "watch not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "watch not found",
+					Error:  "find failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeWatchService{}
+			tt.setup(svc)
+
+			rest.NewWatchHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/watches/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestWatches_List(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeWatchService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeWatchService) {
+				s.ListReturns(
+					[]internal.Watch{
+						{
+							ID:        "a-b-c",
+							URL:       "https://example.com",
+							Schedule:  "@daily",
+							NextRunAt: time.Date(2021, 7, 3, 0, 0, 0, 0, time.UTC),
+							CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						},
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ListWatchesResponse{
+					Watches: []rest.Watch{
+						{
+							ID:        "a-b-c",
+							URL:       "https://example.com",
+							Schedule:  "@daily",
+							NextRunAt: time.Date(2021, 7, 3, 0, 0, 0, 0, time.UTC),
+							CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+							UpdatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+				&rest.ListWatchesResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeWatchService) {
+				s.ListReturns(nil, errors.New("failed"))
+			},
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Type:   "/problems/internal",
+					Title:  "Internal error",
+					Status: http.StatusInternalServerError,
+					Error:  "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeWatchService{}
+			tt.setup(svc)
+
+			rest.NewWatchHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/watches", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestWatches_Delete(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeWatchService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeWatchService) {},
+			output{
+				http.StatusOK,
+				&struct{}{},
+				&struct{}{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeWatchService) {
+				s.DeleteReturns(internal.NewErrorf(internal.ErrorCodeNotFound, "watch not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "watch not found",
+					Error:  "delete failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeWatchService{}
+			tt.setup(svc)
+
+			rest.NewWatchHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodDelete, "/watches/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
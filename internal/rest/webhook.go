@@ -0,0 +1,222 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+//go:generate counterfeiter -o resttesting/webhook_service.gen.go . WebhookService
+
+// WebhookService
+type WebhookService interface {
+	Create(ctx context.Context, webhook internal.Webhook) (internal.Webhook, error)
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.Webhook, error)
+	List(ctx context.Context) ([]internal.Webhook, error)
+	Deliveries(ctx context.Context, id string) ([]internal.WebhookDelivery, error)
+}
+
+// WebhookHandler
+type WebhookHandler struct {
+	svc WebhookService
+}
+
+// NewWebhookHandler
+func NewWebhookHandler(svc WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		svc: svc,
+	}
+}
+
+// Register connects the handlers to the router.
+func (h *WebhookHandler) Register(r *mux.Router) {
+	r.HandleFunc("/webhooks", h.create).Methods(http.MethodPost)
+	r.HandleFunc("/webhooks", h.list).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/webhooks/{id:%s}", uuidRegEx), h.find).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/webhooks/{id:%s}", uuidRegEx), h.delete).Methods(http.MethodDelete)
+	r.HandleFunc(fmt.Sprintf("/webhooks/{id:%s}/deliveries", uuidRegEx), h.deliveries).Methods(http.MethodGet)
+}
+
+// Webhook is a subscription to events, the secret signing the deliveries is only returned on creation.
+type Webhook struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func newWebhook(webhook internal.Webhook) Webhook {
+	events := make([]string, len(webhook.Events))
+	for i, event := range webhook.Events {
+		events[i] = string(event)
+	}
+
+	return Webhook{
+		ID:        webhook.ID,
+		URL:       webhook.URL,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+// WebhookDelivery is one event sent to a webhook, ResponseStatus and Error describe its latest attempt.
+type WebhookDelivery struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhookId"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  time.Time       `json:"nextAttemptAt"`
+	ResponseStatus int             `json:"responseStatus,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	CreatedAt      time.Time       `json:"createdAt"`
+	UpdatedAt      time.Time       `json:"updatedAt"`
+}
+
+func newWebhookDelivery(delivery internal.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		Event:          string(delivery.Event),
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		ResponseStatus: delivery.ResponseStatus,
+		Error:          delivery.Error,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+// CreateWebhooksRequest defines the request used for creating webhooks, a secret is generated when
+// Secret is empty.
+type CreateWebhooksRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Secret string   `json:"secret"`
+}
+
+// WebhookResponse defines the response returned back after creating or searching one webhook.
+type WebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+func (h *WebhookHandler) create(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhooksRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request",
+			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json decoder"))
+		return
+	}
+
+	defer r.Body.Close()
+
+	events := make([]internal.WebhookEvent, len(req.Events))
+	for i, event := range req.Events {
+		events[i] = internal.WebhookEvent(event)
+	}
+
+	webhook, err := h.svc.Create(r.Context(), internal.Webhook{
+		URL:    req.URL,
+		Events: events,
+		Secret: req.Secret,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create failed", err)
+		return
+	}
+
+	res := newWebhook(webhook)
+	res.Secret = webhook.Secret
+
+	renderResponse(w,
+		&WebhookResponse{
+			Webhook: res,
+		},
+		http.StatusCreated)
+}
+
+func (h *WebhookHandler) delete(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	if err := h.svc.Delete(r.Context(), id); err != nil {
+		renderErrorResponse(r.Context(), w, "delete failed", err)
+		return
+	}
+
+	renderResponse(w, struct{}{}, http.StatusOK)
+}
+
+func (h *WebhookHandler) find(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	webhook, err := h.svc.Find(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find failed", err)
+		return
+	}
+
+	renderResponse(w,
+		&WebhookResponse{
+			Webhook: newWebhook(webhook),
+		},
+		http.StatusOK)
+}
+
+// ListWebhooksResponse defines the response returned back after listing webhooks.
+type ListWebhooksResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+func (h *WebhookHandler) list(w http.ResponseWriter, r *http.Request) {
+	webhooks, err := h.svc.List(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "list failed", err)
+		return
+	}
+
+	resp := ListWebhooksResponse{
+		Webhooks: make([]Webhook, 0, len(webhooks)),
+	}
+
+	for _, webhook := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, newWebhook(webhook))
+	}
+
+	renderResponse(w, &resp, http.StatusOK)
+}
+
+// ReadWebhookDeliveriesResponse defines the response returned back after searching the deliveries of a webhook.
+type ReadWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+func (h *WebhookHandler) deliveries(w http.ResponseWriter, r *http.Request) {
+	id, _ := mux.Vars(r)["id"]
+
+	deliveries, err := h.svc.Deliveries(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find deliveries failed", err)
+		return
+	}
+
+	resp := ReadWebhookDeliveriesResponse{
+		Deliveries: make([]WebhookDelivery, 0, len(deliveries)),
+	}
+
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, newWebhookDelivery(delivery))
+	}
+
+	renderResponse(w, &resp, http.StatusOK)
+}
@@ -0,0 +1,312 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/rest"
+	"github.com/Oguzyildirim/url-info/internal/rest/resttesting"
+)
+
+func TestWebhooks_Create(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeWebhookService)
+		input  []byte
+		output output
+	}{
+		{
+			"OK: 201",
+			func(s *resttesting.FakeWebhookService) {
+				s.CreateReturns(
+					internal.Webhook{
+						ID:        "a-b-c",
+						URL:       "https://hooks.example.com",
+						Events:    []internal.WebhookEvent{internal.WebhookEventAnalysisCompleted},
+						Secret:    "s3cr3t",
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+					nil)
+			},
+			func() []byte {
+				b, _ := json.Marshal(&rest.CreateWebhooksRequest{
+					URL:    "https://hooks.example.com",
+					Events: []string{"analysis.completed"},
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusCreated,
+				&rest.WebhookResponse{
+					Webhook: rest.Webhook{
+						ID:        "a-b-c",
+						URL:       "https://hooks.example.com",
+						Events:    []string{"analysis.completed"},
+						Secret:    "s3cr3t",
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				&rest.WebhookResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(*resttesting.FakeWebhookService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: "json decoder: unexpected EOF",
+					Error:  "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 event",
+			func(s *resttesting.FakeWebhookService) {
+				s.CreateReturns(internal.Webhook{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, `unsupported event "page.deleted"`))
+			},
+			[]byte(`{"url":"https://hooks.example.com","events":["page.deleted"]}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Type:   "/problems/invalid-argument",
+					Title:  "Invalid argument",
+					Status: http.StatusBadRequest,
+					Detail: `unsupported event "page.deleted"`,
+					Error:  "create failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeWebhookService{}
+			tt.setup(svc)
+
+			rest.NewWebhookHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(tt.input)))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestWebhooks_Find(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeWebhookService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeWebhookService) {
+				s.FindReturns(
+					internal.Webhook{
+						ID:        "a-b-c",
+						URL:       "https://hooks.example.com",
+						Events:    []internal.WebhookEvent{internal.WebhookEventTitleChanged, internal.WebhookEventFetchFailed},
+						Secret:    "s3cr3t",
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.WebhookResponse{
+					Webhook: rest.Webhook{
+						ID:        "a-b-c",
+						URL:       "https://hooks.example.com",
+						Events:    []string{"title.changed", "fetch.failed"},
+						CreatedAt: time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+					},
+				},
+				&rest.WebhookResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeWebhookService) {
+				s.FindReturns(internal.Webhook{},
+					internal.NewErrorf(internal.ErrorCodeNotFound, "webhook not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "webhook not found",
+					Error:  "find failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeWebhookService{}
+			tt.setup(svc)
+
+			rest.NewWebhookHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/webhooks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestWebhooks_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeWebhookService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeWebhookService) {
+				s.DeliveriesReturns(
+					[]internal.WebhookDelivery{
+						{
+							ID:             "d-e-f",
+							WebhookID:      "a-b-c",
+							Event:          internal.WebhookEventFetchFailed,
+							Payload:        []byte(`{"event":"fetch.failed"}`),
+							Status:         internal.WebhookDeliveryStatusPending,
+							Attempts:       2,
+							NextAttemptAt:  time.Date(2021, 7, 2, 10, 1, 0, 0, time.UTC),
+							ResponseStatus: http.StatusServiceUnavailable,
+							Error:          "webhook responded with status 503",
+							CreatedAt:      time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+							UpdatedAt:      time.Date(2021, 7, 2, 10, 0, 30, 0, time.UTC),
+						},
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadWebhookDeliveriesResponse{
+					Deliveries: []rest.WebhookDelivery{
+						{
+							ID:             "d-e-f",
+							WebhookID:      "a-b-c",
+							Event:          "fetch.failed",
+							Payload:        []byte(`{"event":"fetch.failed"}`),
+							Status:         "pending",
+							Attempts:       2,
+							NextAttemptAt:  time.Date(2021, 7, 2, 10, 1, 0, 0, time.UTC),
+							ResponseStatus: http.StatusServiceUnavailable,
+							Error:          "webhook responded with status 503",
+							CreatedAt:      time.Date(2021, 7, 2, 10, 0, 0, 0, time.UTC),
+							UpdatedAt:      time.Date(2021, 7, 2, 10, 0, 30, 0, time.UTC),
+						},
+					},
+				},
+				&rest.ReadWebhookDeliveriesResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeWebhookService) {
+				s.DeliveriesReturns(nil,
+					internal.NewErrorf(internal.ErrorCodeNotFound, "webhook not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Type:   "/problems/not-found",
+					Title:  "Resource not found",
+					Status: http.StatusNotFound,
+					Detail: "webhook not found",
+					Error:  "find deliveries failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeWebhookService{}
+			tt.setup(svc)
+
+			rest.NewWebhookHandler(svc).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/webhooks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/deliveries", nil))
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
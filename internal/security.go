@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"time"
+)
+
+// SecurityGrade summarizes the security of a page, from A to F
+type SecurityGrade string
+
+const (
+	SecurityGradeA SecurityGrade = "A"
+	SecurityGradeB SecurityGrade = "B"
+	SecurityGradeC SecurityGrade = "C"
+	SecurityGradeD SecurityGrade = "D"
+	SecurityGradeF SecurityGrade = "F"
+)
+
+// SecurityWarning flags a problem of the security headers or the transport of a page
+type SecurityWarning string
+
+const (
+	SecurityWarningTLSMissing                 SecurityWarning = "tls.missing"
+	SecurityWarningTLSExpiring                SecurityWarning = "tls.expiring"
+	SecurityWarningHSTSMissing                SecurityWarning = "hsts.missing"
+	SecurityWarningHSTSShortMaxAge            SecurityWarning = "hsts.short-max-age"
+	SecurityWarningCSPMissing                 SecurityWarning = "csp.missing"
+	SecurityWarningCSPUnsafeInline            SecurityWarning = "csp.unsafe-inline"
+	SecurityWarningCSPUnsafeEval              SecurityWarning = "csp.unsafe-eval"
+	SecurityWarningXFrameOptionsMissing       SecurityWarning = "x-frame-options.missing"
+	SecurityWarningXContentTypeOptionsMissing SecurityWarning = "x-content-type-options.missing"
+	SecurityWarningReferrerPolicyMissing      SecurityWarning = "referrer-policy.missing"
+	SecurityWarningPermissionsPolicyMissing   SecurityWarning = "permissions-policy.missing"
+	SecurityWarningCookieInsecure             SecurityWarning = "cookie.insecure"
+	SecurityWarningCookieNoHTTPOnly           SecurityWarning = "cookie.no-httponly"
+	SecurityWarningCookieNoSameSite           SecurityWarning = "cookie.no-samesite"
+)
+
+// Security is the analysis of the security headers, cookies and TLS connection of the final response, it's
+// stored as the security section of the analysis
+type Security struct {
+	// Score starts at 100 and loses points for every warning, Grade is derived from it
+	Grade               SecurityGrade    `json:"grade"`
+	Score               int              `json:"score"`
+	HSTS                HSTS             `json:"hsts"`
+	CSP                 CSP              `json:"csp"`
+	XFrameOptions       string           `json:"xFrameOptions"`
+	XContentTypeOptions string           `json:"xContentTypeOptions"`
+	ReferrerPolicy      string           `json:"referrerPolicy"`
+	PermissionsPolicy   string           `json:"permissionsPolicy"`
+	Cookies             []SecurityCookie `json:"cookies"`
+	// TLS is nil when the page was not fetched over HTTPS
+	TLS      *TLS              `json:"tls"`
+	Warnings []SecurityWarning `json:"warnings"`
+}
+
+// HSTS is the Strict-Transport-Security policy of a page
+type HSTS struct {
+	Present bool `json:"present"`
+	// MaxAge is in seconds
+	MaxAge            int64 `json:"maxAge"`
+	IncludeSubDomains bool  `json:"includeSubDomains"`
+	Preload           bool  `json:"preload"`
+}
+
+// CSP is the Content-Security-Policy of a page
+type CSP struct {
+	Present bool `json:"present"`
+	// Directives map the lowercase directive names to their sources
+	Directives map[string][]string `json:"directives"`
+	// UnsafeInline is set when a directive allows 'unsafe-inline' without a nonce or hash source
+	UnsafeInline bool `json:"unsafeInline"`
+	UnsafeEval   bool `json:"unsafeEval"`
+}
+
+// SecurityCookie is a cookie set by a page along with its security attributes
+type SecurityCookie struct {
+	Name     string `json:"name"`
+	Secure   bool   `json:"secure"`
+	HTTPOnly bool   `json:"httpOnly"`
+	// SameSite is empty when the attribute is not set
+	SameSite string `json:"sameSite"`
+}
+
+// TLS describes the connection a page was fetched over
+type TLS struct {
+	Version     string    `json:"version"`
+	CipherSuite string    `json:"cipherSuite"`
+	Subject     string    `json:"subject"`
+	Issuer      string    `json:"issuer"`
+	NotAfter    time.Time `json:"notAfter"`
+	// ExpiresInDays is never negative, pages served with an expired certificate fail to be fetched
+	ExpiresInDays int `json:"expiresInDays"`
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// unlabeledInputTypes are the input types named by their value or not displayed, they don't need a label
+var unlabeledInputTypes = map[string]bool{
+	"hidden": true,
+	"submit": true,
+	"reset":  true,
+	"button": true,
+	"image":  true,
+}
+
+// accessibilityAnalyzer reports the static accessibility issues of the page as the accessibility section
+type accessibilityAnalyzer struct{}
+
+func (accessibilityAnalyzer) Name() string { return "accessibility" }
+
+func (accessibilityAnalyzer) Concurrent() bool { return true }
+
+func (a accessibilityAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	return NewSection(a.Name(), detectAccessibility(page.Document))
+}
+
+// accessibilityAudit collects the issues of one document
+type accessibilityAudit struct {
+	doc    *goquery.Document
+	ids    map[string]*html.Node
+	issues []internal.AccessibilityIssue
+}
+
+func detectAccessibility(doc *goquery.Document) internal.Accessibility {
+	audit := accessibilityAudit{
+		doc:    doc,
+		ids:    make(map[string]*html.Node),
+		issues: []internal.AccessibilityIssue{},
+	}
+
+	audit.checkHTMLLang()
+	audit.checkDuplicateIDs()
+	audit.checkImageAlt()
+	audit.checkInputLabels()
+	audit.checkHeadingOrder()
+	audit.checkNames("a[href]", internal.AccessibilityRuleLinkName, internal.AccessibilitySeveritySerious, "link has no accessible name")
+	audit.checkNames("button", internal.AccessibilityRuleButtonName, internal.AccessibilitySeverityCritical, "button has no accessible name")
+	audit.checkTableHeaders()
+
+	return internal.Accessibility{Issues: audit.issues}
+}
+
+func (a *accessibilityAudit) report(item *goquery.Selection, rule internal.AccessibilityRule, severity internal.AccessibilitySeverity, message string) {
+	a.issues = append(a.issues, internal.AccessibilityIssue{
+		Rule:     rule,
+		Severity: severity,
+		Location: cssPath(item.Get(0)),
+		Message:  message,
+	})
+}
+
+func (a *accessibilityAudit) checkHTMLLang() {
+	root := a.doc.Find("html").First()
+
+	if lang, _ := root.Attr("lang"); root.Length() > 0 && strings.TrimSpace(lang) == "" {
+		a.report(root, internal.AccessibilityRuleHTMLLang, internal.AccessibilitySeveritySerious, "html element has no lang attribute")
+	}
+}
+
+// checkDuplicateIDs reports every element reusing the id of a previous one, the first elements are kept for
+// resolving labels
+func (a *accessibilityAudit) checkDuplicateIDs() {
+	a.doc.Find("[id]").Each(func(_ int, item *goquery.Selection) {
+		id, _ := item.Attr("id")
+		if id = strings.TrimSpace(id); id == "" {
+			return
+		}
+
+		if _, ok := a.ids[id]; ok {
+			a.report(item, internal.AccessibilityRuleDuplicateID, internal.AccessibilitySeverityMinor, fmt.Sprintf("id %q is already used", id))
+			return
+		}
+
+		a.ids[id] = item.Get(0)
+	})
+}
+
+// checkImageAlt reports images without alt attribute, an empty alt marks a decorative image
+func (a *accessibilityAudit) checkImageAlt() {
+	a.doc.Find("img").Each(func(_ int, item *goquery.Selection) {
+		if _, ok := item.Attr("alt"); !ok && !hasRole(item, "presentation", "none") {
+			a.report(item, internal.AccessibilityRuleImageAlt, internal.AccessibilitySeverityCritical, "image has no alt attribute")
+		}
+	})
+}
+
+func (a *accessibilityAudit) checkInputLabels() {
+	labeled := make(map[string]bool)
+
+	a.doc.Find("label[for]").Each(func(_ int, item *goquery.Selection) {
+		id, _ := item.Attr("for")
+		labeled[strings.TrimSpace(id)] = true
+	})
+
+	a.doc.Find("input, select, textarea").Each(func(_ int, item *goquery.Selection) {
+		if goquery.NodeName(item) == "input" {
+			typ, _ := item.Attr("type")
+			if unlabeledInputTypes[strings.ToLower(strings.TrimSpace(typ))] {
+				return
+			}
+		}
+
+		id, _ := item.Attr("id")
+		id = strings.TrimSpace(id)
+		title, _ := item.Attr("title")
+
+		switch {
+		case id != "" && labeled[id]:
+		case item.Closest("label").Length() > 0:
+		case a.ariaName(item) != "":
+		case strings.TrimSpace(title) != "":
+		default:
+			a.report(item, internal.AccessibilityRuleInputLabel, internal.AccessibilitySeverityCritical,
+				fmt.Sprintf("%s has no label", goquery.NodeName(item)))
+		}
+	})
+}
+
+// checkHeadingOrder reports headings more than one level below the previous heading
+func (a *accessibilityAudit) checkHeadingOrder() {
+	previous := 0
+
+	a.doc.Find("h1, h2, h3, h4, h5, h6").Each(func(_ int, item *goquery.Selection) {
+		level := int(goquery.NodeName(item)[1] - '0')
+
+		if previous > 0 && level > previous+1 {
+			a.report(item, internal.AccessibilityRuleHeadingOrder, internal.AccessibilitySeverityModerate,
+				fmt.Sprintf("h%d follows h%d", level, previous))
+		}
+
+		previous = level
+	})
+}
+
+// checkNames reports the elements matching selector without text, alternative text of images, ARIA label or
+// title
+func (a *accessibilityAudit) checkNames(selector string, rule internal.AccessibilityRule, severity internal.AccessibilitySeverity, message string) {
+	a.doc.Find(selector).Each(func(_ int, item *goquery.Selection) {
+		title, _ := item.Attr("title")
+
+		switch {
+		case a.ariaName(item) != "":
+		case strings.TrimSpace(textAlternative(item.Get(0))) != "":
+		case strings.TrimSpace(title) != "":
+		default:
+			a.report(item, rule, severity, message)
+		}
+	})
+}
+
+// checkTableHeaders reports tables without header cells, layout tables are expected to have the presentation
+// role
+func (a *accessibilityAudit) checkTableHeaders() {
+	a.doc.Find("table").Each(func(_ int, item *goquery.Selection) {
+		if !hasRole(item, "presentation", "none") && item.Find("th").Length() == 0 {
+			a.report(item, internal.AccessibilityRuleTableHeaders, internal.AccessibilitySeveritySerious, "table has no header cells")
+		}
+	})
+}
+
+// ariaName returns the name given by the aria-label attribute or the text of the aria-labelledby elements
+func (a *accessibilityAudit) ariaName(item *goquery.Selection) string {
+	if label, _ := item.Attr("aria-label"); strings.TrimSpace(label) != "" {
+		return strings.TrimSpace(label)
+	}
+
+	ids, _ := item.Attr("aria-labelledby")
+
+	var texts []string
+
+	for _, id := range strings.Fields(ids) {
+		if node, ok := a.ids[id]; ok {
+			texts = append(texts, textAlternative(node))
+		}
+	}
+
+	return strings.Join(strings.Fields(strings.Join(texts, " ")), " ")
+}
+
+func hasRole(item *goquery.Selection, roles ...string) bool {
+	role, _ := item.Attr("role")
+
+	return hasToken(roles, strings.ToLower(strings.TrimSpace(role)))
+}
+
+// textAlternative returns the text of the node with images replaced by their alternative text
+func textAlternative(node *html.Node) string {
+	switch node.Type {
+	case html.TextNode:
+		return node.Data
+	case html.ElementNode:
+		if node.Data == "img" {
+			for _, attr := range node.Attr {
+				if attr.Key == "alt" {
+					return " " + attr.Val + " "
+				}
+			}
+
+			return ""
+		}
+	}
+
+	var text strings.Builder
+
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		text.WriteString(textAlternative(child))
+	}
+
+	return text.String()
+}
+
+// cssPath returns the selector of the node from the html element, the position among the siblings of the same
+// type is only added when needed
+func cssPath(node *html.Node) string {
+	var parts []string
+
+	for n := node; n != nil && n.Type == html.ElementNode; n = n.Parent {
+		part := n.Data
+
+		if n.Parent != nil {
+			index, count := 0, 0
+
+			for sibling := n.Parent.FirstChild; sibling != nil; sibling = sibling.NextSibling {
+				if sibling.Type == html.ElementNode && sibling.Data == n.Data {
+					count++
+
+					if sibling == n {
+						index = count
+					}
+				}
+			}
+
+			if count > 1 {
+				part += fmt.Sprintf(":nth-of-type(%d)", index)
+			}
+		}
+
+		parts = append([]string{part}, parts...)
+	}
+
+	return strings.Join(parts, " > ")
+}
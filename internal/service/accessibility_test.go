@@ -0,0 +1,124 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDetectAccessibility(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []internal.AccessibilityIssue
+	}{
+		{
+			"OK: no issues",
+			`<html lang="en"><body>
+<h1>Title</h1><h2>Section</h2><h3>Sub</h3><h2>Other</h2>
+<img src="/logo.png" alt="Logo"><img src="/line.png" alt="">
+<label for="q">Search</label><input id="q" name="q">
+<label>Email <input type="email" name="email"></label>
+<input type="hidden" name="token"><input type="submit">
+<textarea aria-label="Comment"></textarea>
+<span id="sort-label">Sort</span><select aria-labelledby="sort-label"></select>
+<a href="/"><img src="/home.png" alt="Home"></a><a href="/help" title="Help"></a>
+<button aria-label="Close"></button><button>Save</button>
+<table><tr><th>Name</th></tr></table><table role="presentation"><tr><td>Layout</td></tr></table>
+</body></html>`,
+			[]internal.AccessibilityIssue{},
+		},
+		{
+			"OK: issues",
+			`<html><body>
+<h1>Title</h1><h3>Skipped</h3>
+<div id="main"><img src="/a.png"><img src="/b.png" alt="B"></div>
+<div id="main"><input name="q" placeholder="Search"><select aria-labelledby="missing"></select></div>
+<a href="/"> </a><a name="anchor"></a>
+<button><span></span></button>
+<table><tr><td>Cell</td></tr></table>
+</body></html>`,
+			[]internal.AccessibilityIssue{
+				{
+					Rule:     internal.AccessibilityRuleHTMLLang,
+					Severity: internal.AccessibilitySeveritySerious,
+					Location: "html",
+					Message:  "html element has no lang attribute",
+				},
+				{
+					Rule:     internal.AccessibilityRuleDuplicateID,
+					Severity: internal.AccessibilitySeverityMinor,
+					Location: "html > body > div:nth-of-type(2)",
+					Message:  `id "main" is already used`,
+				},
+				{
+					Rule:     internal.AccessibilityRuleImageAlt,
+					Severity: internal.AccessibilitySeverityCritical,
+					Location: "html > body > div:nth-of-type(1) > img:nth-of-type(1)",
+					Message:  "image has no alt attribute",
+				},
+				{
+					Rule:     internal.AccessibilityRuleInputLabel,
+					Severity: internal.AccessibilitySeverityCritical,
+					Location: "html > body > div:nth-of-type(2) > input",
+					Message:  "input has no label",
+				},
+				{
+					Rule:     internal.AccessibilityRuleInputLabel,
+					Severity: internal.AccessibilitySeverityCritical,
+					Location: "html > body > div:nth-of-type(2) > select",
+					Message:  "select has no label",
+				},
+				{
+					Rule:     internal.AccessibilityRuleHeadingOrder,
+					Severity: internal.AccessibilitySeverityModerate,
+					Location: "html > body > h3",
+					Message:  "h3 follows h1",
+				},
+				{
+					Rule:     internal.AccessibilityRuleLinkName,
+					Severity: internal.AccessibilitySeveritySerious,
+					Location: "html > body > a:nth-of-type(1)",
+					Message:  "link has no accessible name",
+				},
+				{
+					Rule:     internal.AccessibilityRuleButtonName,
+					Severity: internal.AccessibilitySeverityCritical,
+					Location: "html > body > button",
+					Message:  "button has no accessible name",
+				},
+				{
+					Rule:     internal.AccessibilityRuleTableHeaders,
+					Severity: internal.AccessibilitySeveritySerious,
+					Location: "html > body > table",
+					Message:  "table has no header cells",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual := detectAccessibility(doc)
+
+			if !cmp.Equal(tt.expected, actual.Issues) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual.Issues))
+			}
+		})
+	}
+}
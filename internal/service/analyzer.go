@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// Page is the fetched page the analyzers inspect
+type Page struct {
+	Fetch    FetchResult
+	Document *goquery.Document
+	// Base is the URL the relative references of the document resolve against
+	Base *url.URL
+}
+
+// Analysis collects the findings of the analyzers of one page
+type Analysis struct {
+	URL   internal.URL
+	Links []internal.Link
+}
+
+// Finding is what an analyzer found on a page, findings are applied one at a time in registration order
+// once every analyzer is done
+type Finding interface {
+	Apply(analysis *Analysis)
+}
+
+// FindingFunc is a Finding applied by calling the function
+type FindingFunc func(analysis *Analysis)
+
+// Apply calls f
+func (f FindingFunc) Apply(analysis *Analysis) {
+	f(analysis)
+}
+
+// NewSection returns the Finding storing v as the section name of the analysis, it's how analyzers record
+// their findings without a dedicated field
+func NewSection(name string, v interface{}) (Finding, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal section %s: %w", name, err)
+	}
+
+	return FindingFunc(func(analysis *Analysis) {
+		if analysis.URL.Sections == nil {
+			analysis.URL.Sections = make(map[string]json.RawMessage)
+		}
+
+		analysis.URL.Sections[name] = raw
+	}), nil
+}
+
+// Analyzer inspects a page, the page is shared with the other analyzers and must not be modified by
+// concurrent analyzers
+type Analyzer interface {
+	// Name identifies the analyzer, it's used for enabling it and as the name of its section
+	Name() string
+	// Concurrent indicates the analyzer may run at the same time as the other concurrent analyzers
+	Concurrent() bool
+	Analyze(ctx context.Context, page *Page) (Finding, error)
+}
+
+// AnalyzerConfig defines which analyzers are run, zero values run all of them
+type AnalyzerConfig struct {
+	Disabled []string
+	// CheckSubresources enables checking the availability of the subresources with the link checker
+	CheckSubresources bool
+}
+
+// AnalyzerRegistry runs the enabled analyzers on every page
+type AnalyzerRegistry struct {
+	analyzers []Analyzer
+	disabled  map[string]bool
+}
+
+// NewAnalyzerRegistry instantiates a registry of analyzers, disabling an analyzer not registered fails
+func NewAnalyzerRegistry(config AnalyzerConfig, analyzers ...Analyzer) (*AnalyzerRegistry, error) {
+	registry := &AnalyzerRegistry{
+		disabled: make(map[string]bool),
+	}
+
+	for _, analyzer := range analyzers {
+		if err := registry.Register(analyzer); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, name := range config.Disabled {
+		if name == "" {
+			continue
+		}
+
+		if !registry.registered(name) {
+			return nil, fmt.Errorf("unknown analyzer %q", name)
+		}
+
+		registry.disabled[name] = true
+	}
+
+	return registry, nil
+}
+
+// Register adds the analyzer, its findings are applied after the ones of the analyzers registered before
+func (r *AnalyzerRegistry) Register(analyzer Analyzer) error {
+	if r.registered(analyzer.Name()) {
+		return fmt.Errorf("analyzer %q already registered", analyzer.Name())
+	}
+
+	r.analyzers = append(r.analyzers, analyzer)
+
+	return nil
+}
+
+func (r *AnalyzerRegistry) registered(name string) bool {
+	for _, analyzer := range r.analyzers {
+		if analyzer.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run analyzes the page with the enabled analyzers and applies their findings to analysis. The concurrent
+// analyzers run first all at once, the others run afterwards one at a time.
+func (r *AnalyzerRegistry) Run(ctx context.Context, page *Page, analysis *Analysis) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "AnalyzerRegistry.Run")
+	defer span.End()
+
+	findings := make([]Finding, len(r.analyzers))
+	errs := make([]error, len(r.analyzers))
+
+	var wg sync.WaitGroup
+
+	for i, analyzer := range r.analyzers {
+		if r.disabled[analyzer.Name()] || !analyzer.Concurrent() {
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, analyzer Analyzer) {
+			defer wg.Done()
+
+			findings[i], errs[i] = analyze(ctx, analyzer, page)
+		}(i, analyzer)
+	}
+
+	wg.Wait()
+
+	for i, analyzer := range r.analyzers {
+		if r.disabled[analyzer.Name()] || analyzer.Concurrent() {
+			continue
+		}
+
+		findings[i], errs[i] = analyze(ctx, analyzer, page)
+	}
+
+	for i, analyzer := range r.analyzers {
+		if errs[i] != nil {
+			return fmt.Errorf("analyzer %s: %w", analyzer.Name(), errs[i])
+		}
+	}
+
+	for _, finding := range findings {
+		if finding != nil {
+			finding.Apply(analysis)
+		}
+	}
+
+	return nil
+}
+
+func analyze(ctx context.Context, analyzer Analyzer, page *Page) (Finding, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "Analyzer.Analyze")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("analyzer.name", analyzer.Name()))
+
+	return analyzer.Analyze(ctx, page)
+}
+
+// DefaultAnalyzers returns the analyzers run on every page unless disabled, in registration order
+func DefaultAnalyzers(checker *LinkChecker, config AnalyzerConfig) []Analyzer {
+	subresources := subresourcesAnalyzer{}
+	if config.CheckSubresources {
+		subresources.checker = checker
+	}
+
+	return []Analyzer{
+		doctypeAnalyzer{},
+		titleAnalyzer{},
+		headingsAnalyzer{},
+		linksAnalyzer{checker: checker},
+		formsAnalyzer{},
+		metadataAnalyzer{},
+		structuredDataAnalyzer{},
+		accessibilityAnalyzer{},
+		securityAnalyzer{},
+		subresources,
+	}
+}
+
+// doctypeAnalyzer detects the HTML version from the doctype as sent by the server
+type doctypeAnalyzer struct{}
+
+func (doctypeAnalyzer) Name() string { return "doctype" }
+
+func (doctypeAnalyzer) Concurrent() bool { return true }
+
+func (doctypeAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	doctype := detectDoctype(page.Fetch.Body)
+
+	return FindingFunc(func(analysis *Analysis) {
+		analysis.URL.HTMLVersion = htmlVersion(doctype)
+		analysis.URL.Doctype = doctype
+	}), nil
+}
+
+// titleAnalyzer detects the page title
+type titleAnalyzer struct{}
+
+func (titleAnalyzer) Name() string { return "title" }
+
+func (titleAnalyzer) Concurrent() bool { return true }
+
+func (titleAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	pageTitle := detectPageTitle(page.Document)
+
+	return FindingFunc(func(analysis *Analysis) {
+		analysis.URL.PageTitle = pageTitle
+	}), nil
+}
+
+// headingsAnalyzer counts the headings by level
+type headingsAnalyzer struct{}
+
+func (headingsAnalyzer) Name() string { return "headings" }
+
+func (headingsAnalyzer) Concurrent() bool { return true }
+
+func (headingsAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	headings := detectHeadings(page.Document)
+
+	return FindingFunc(func(analysis *Analysis) {
+		analysis.URL.Headings = headings
+	}), nil
+}
+
+// linksAnalyzer checks every link of the page
+type linksAnalyzer struct {
+	checker *LinkChecker
+}
+
+func (linksAnalyzer) Name() string { return "links" }
+
+func (linksAnalyzer) Concurrent() bool { return true }
+
+func (a linksAnalyzer) Analyze(ctx context.Context, page *Page) (Finding, error) {
+	anchors := detectAnchors(page.Document)
+
+	hrefs := make([]string, len(anchors))
+	for i, anchor := range anchors {
+		hrefs[i] = anchor.href
+	}
+
+	results := a.checker.Check(ctx, page.Base, hrefs)
+
+	// the counters are collected apart and copied over when applied
+	counts := internal.URL{
+		LinksCount: detectLinks(page.Document),
+	}
+
+	links := make([]internal.Link, len(results))
+	for i, result := range results {
+		links[i] = newLink(page.Fetch.URL, anchors[i].text, result)
+
+		countLink(&counts, anchors[i], result, links[i])
+	}
+
+	return FindingFunc(func(analysis *Analysis) {
+		analysis.URL.LinksCount = counts.LinksCount
+		analysis.URL.InaccessibleLinksCount = counts.InaccessibleLinksCount
+		analysis.URL.InternalLinksCount = counts.InternalLinksCount
+		analysis.URL.ExternalLinksCount = counts.ExternalLinksCount
+		analysis.URL.NofollowLinksCount = counts.NofollowLinksCount
+		analysis.URL.SponsoredLinksCount = counts.SponsoredLinksCount
+		analysis.URL.UGCLinksCount = counts.UGCLinksCount
+		analysis.URL.UnsafeBlankLinksCount = counts.UnsafeBlankLinksCount
+		analysis.Links = links
+	}), nil
+}
+
+// formsAnalyzer classifies the forms of the page
+type formsAnalyzer struct{}
+
+func (formsAnalyzer) Name() string { return "forms" }
+
+func (formsAnalyzer) Concurrent() bool { return true }
+
+func (formsAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	forms := detectForms(page.Document, page.Base)
+
+	return FindingFunc(func(analysis *Analysis) {
+		analysis.URL.Forms = forms
+
+		for _, form := range forms {
+			if form.Kind == internal.FormKindLogin {
+				analysis.URL.HaveLoginForm = true
+			}
+		}
+	}), nil
+}
@@ -0,0 +1,177 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/service"
+)
+
+type fakeAnalyzer struct {
+	name       string
+	concurrent bool
+	finding    func(*service.Page) (service.Finding, error)
+}
+
+func (a fakeAnalyzer) Name() string { return a.name }
+
+func (a fakeAnalyzer) Concurrent() bool { return a.concurrent }
+
+func (a fakeAnalyzer) Analyze(_ context.Context, page *service.Page) (service.Finding, error) {
+	return a.finding(page)
+}
+
+func TestAnalyzerRegistry_Run(t *testing.T) {
+	t.Parallel()
+
+	var running int32
+
+	// appendTitle records the order findings are applied in, and fails when other analyzers are running
+	// while a sequential one is
+	appendTitle := func(suffix string, concurrent bool) func(*service.Page) (service.Finding, error) {
+		return func(*service.Page) (service.Finding, error) {
+			if n := atomic.AddInt32(&running, 1); !concurrent && n != 1 {
+				return nil, errors.New("sequential analyzer running alongside others")
+			}
+			defer atomic.AddInt32(&running, -1)
+
+			return service.FindingFunc(func(analysis *service.Analysis) {
+				analysis.URL.PageTitle += suffix
+			}), nil
+		}
+	}
+
+	section := func(*service.Page) (service.Finding, error) {
+		return service.NewSection("custom", map[string]int{"count": 2})
+	}
+
+	tests := []struct {
+		name      string
+		config    service.AnalyzerConfig
+		analyzers []service.Analyzer
+		expected  internal.URL
+		err       bool
+	}{
+		{
+			"OK",
+			service.AnalyzerConfig{Disabled: []string{"", "c"}},
+			[]service.Analyzer{
+				fakeAnalyzer{"a", true, appendTitle("a", true)},
+				fakeAnalyzer{"b", false, appendTitle("b", false)},
+				fakeAnalyzer{"c", true, appendTitle("c", true)},
+				fakeAnalyzer{"d", true, appendTitle("d", true)},
+				fakeAnalyzer{"custom", true, section},
+			},
+			internal.URL{
+				PageTitle: "abd",
+				Sections:  map[string]json.RawMessage{"custom": json.RawMessage(`{"count":2}`)},
+			},
+			false,
+		},
+		{
+			"ERR: analyzer",
+			service.AnalyzerConfig{},
+			[]service.Analyzer{
+				fakeAnalyzer{"a", true, section},
+				fakeAnalyzer{"b", true, func(*service.Page) (service.Finding, error) {
+					return nil, errors.New("failed")
+				}},
+			},
+			internal.URL{},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			registry, err := service.NewAnalyzerRegistry(tt.config, tt.analyzers...)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			var analysis service.Analysis
+
+			err = registry.Run(context.Background(), &service.Page{}, &analysis)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected error %t, got %v", tt.err, err)
+			}
+
+			if !cmp.Equal(tt.expected, analysis.URL) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, analysis.URL))
+			}
+		})
+	}
+}
+
+func TestNewAnalyzerRegistry(t *testing.T) {
+	t.Parallel()
+
+	analyzers := service.DefaultAnalyzers(service.NewLinkChecker(http.DefaultClient, nil, service.LinkCheckerConfig{}), service.AnalyzerConfig{})
+
+	if _, err := service.NewAnalyzerRegistry(service.AnalyzerConfig{Disabled: []string{"links", "forms"}}, analyzers...); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if _, err := service.NewAnalyzerRegistry(service.AnalyzerConfig{Disabled: []string{"unknown"}}, analyzers...); err == nil {
+		t.Fatalf("expected unknown analyzer error")
+	}
+
+	if _, err := service.NewAnalyzerRegistry(service.AnalyzerConfig{}, append(analyzers, analyzers[0])...); err == nil {
+		t.Fatalf("expected duplicate analyzer error")
+	}
+}
+
+func TestDefaultAnalyzers(t *testing.T) {
+	t.Parallel()
+
+	body := `<!DOCTYPE html>
+<html><head><title>Sign in</title></head>
+<body>
+<h1>Welcome</h1>
+<a href="mailto:me@example.com">mail</a>
+<form action="/login" method="post">
+<input name="username"><input type="password" name="password">
+</form>
+</body></html>`
+
+	doc, _ := goquery.NewDocumentFromReader(strings.NewReader(body))
+	pageURL, _ := url.Parse("https://example.com/")
+
+	registry, err := service.NewAnalyzerRegistry(service.AnalyzerConfig{},
+		service.DefaultAnalyzers(service.NewLinkChecker(http.DefaultClient, nil, service.LinkCheckerConfig{}), service.AnalyzerConfig{})...)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var analysis service.Analysis
+
+	page := &service.Page{
+		Fetch:    service.FetchResult{URL: pageURL, Body: []byte(body)},
+		Document: doc,
+		Base:     pageURL,
+	}
+
+	if err := registry.Run(context.Background(), page, &analysis); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if analysis.URL.HTMLVersion != "HTML 5" || analysis.URL.PageTitle != "Sign in" || analysis.URL.Headings.H1 != 1 ||
+		analysis.URL.LinksCount != 1 || len(analysis.Links) != 1 || !analysis.Links[0].Skipped ||
+		!analysis.URL.HaveLoginForm {
+		t.Fatalf("expected the page to be analyzed, got %+v", analysis)
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// coalescer shares one in-flight analysis between the concurrent callers asking for the same key, the
+// analyses run on its own context canceled by shutdown
+type coalescer struct {
+	mu     sync.Mutex
+	calls  map[string]*call
+	closed bool
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+type call struct {
+	done chan struct{}
+	res  internal.URL
+	err  error
+}
+
+func newCoalescer() *coalescer {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &coalescer{
+		calls:  make(map[string]*call),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// do runs fn once for all the concurrent callers of the same key. fn is not canceled when the caller that
+// started it goes away because other callers may be waiting for its result, callers stop waiting when
+// their own context is done.
+func (c *coalescer) do(ctx context.Context, key string, fn func(context.Context) (internal.URL, error)) (res internal.URL, shared bool, err error) {
+	c.mu.Lock()
+
+	if c.closed {
+		c.mu.Unlock()
+
+		return internal.URL{}, false, c.ctx.Err()
+	}
+
+	cl, shared := c.calls[key]
+	if !shared {
+		cl = &call{done: make(chan struct{})}
+		c.calls[key] = cl
+
+		c.wg.Add(1)
+
+		go func() {
+			defer c.wg.Done()
+
+			cl.res, cl.err = fn(trace.ContextWithSpan(c.ctx, trace.SpanFromContext(ctx)))
+
+			c.mu.Lock()
+			delete(c.calls, key)
+			c.mu.Unlock()
+
+			close(cl.done)
+		}()
+	}
+
+	c.mu.Unlock()
+
+	select {
+	case <-cl.done:
+		return cl.res, shared, cl.err
+	case <-ctx.Done():
+		return internal.URL{}, shared, ctx.Err()
+	}
+}
+
+// shutdown cancels the running calls and waits for them to return until ctx is done, later calls fail
+// right away
+func (c *coalescer) shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	c.closed = true
+	c.mu.Unlock()
+
+	c.cancel()
+
+	done := make(chan struct{})
+
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
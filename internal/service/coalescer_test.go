@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestCoalescer_Do(t *testing.T) {
+	t.Parallel()
+
+	const key = "https://example.com/"
+
+	c := newCoalescer()
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	var calls int32
+
+	fn := func(context.Context) (internal.URL, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			started <- struct{}{}
+		}
+
+		<-release
+
+		return internal.URL{ID: "1-2-3"}, nil
+	}
+
+	const callers = 5
+
+	var (
+		wg     sync.WaitGroup
+		owners int32
+	)
+
+	results := make([]internal.URL, callers)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			res, shared, err := c.do(context.Background(), key, fn)
+			if err != nil {
+				t.Errorf("expected no error, got %s", err)
+			}
+
+			if !shared {
+				atomic.AddInt32(&owners, 1)
+			}
+
+			results[i] = res
+		}(i)
+	}
+
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, shared, err := c.do(ctx, key, fn)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if !shared {
+		t.Fatalf("expected the canceled caller to join the in-flight call")
+	}
+
+	close(release)
+	wg.Wait()
+
+	if calls != owners {
+		t.Fatalf("expected fn to run once per owner, ran %d times for %d owners", calls, owners)
+	}
+
+	for _, res := range results {
+		if res.ID != "1-2-3" {
+			t.Fatalf("expected shared result, got %q", res.ID)
+		}
+	}
+}
+
+func TestCoalescer_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	c := newCoalescer()
+	started := make(chan struct{})
+
+	errC := make(chan error, 1)
+
+	go func() {
+		_, _, err := c.do(context.Background(), "https://example.com/", func(ctx context.Context) (internal.URL, error) {
+			close(started)
+
+			<-ctx.Done()
+
+			return internal.URL{}, ctx.Err()
+		})
+
+		errC <- err
+	}()
+
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := c.shutdown(ctx); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := <-errC; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	_, _, err := c.do(context.Background(), "https://example.com/", func(context.Context) (internal.URL, error) {
+		t.Fatalf("expected fn not to run after shutdown")
+
+		return internal.URL{}, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
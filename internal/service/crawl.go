@@ -0,0 +1,298 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const (
+	defaultCrawlMaxDepth = 2
+	defaultCrawlMaxPages = 50
+)
+
+// crawlStaleAfter is how long a crawl can stay running without analyzing a page before another worker
+// claims it again
+const crawlStaleAfter = 10 * time.Minute
+
+// CrawlRepository defines the datastore handling persisting Crawl records
+type CrawlRepository interface {
+	Create(ctx context.Context, crawl internal.Crawl) (internal.Crawl, error)
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.Crawl, error)
+	Claim(ctx context.Context, staleBefore time.Time) (internal.Crawl, error)
+	AddPage(ctx context.Context, id string, position int, page internal.CrawlPage) error
+	Complete(ctx context.Context, id string) error
+	Fail(ctx context.Context, id string, msg string) error
+}
+
+// Crawl defines the application service in charge of analyzing the pages of a site in the background
+type Crawl struct {
+	repo       CrawlRepository
+	urls       *URL
+	normalizer *Normalizer
+	logger     *zap.Logger
+}
+
+// NewCrawl
+func NewCrawl(repo CrawlRepository, urls *URL, normalizer *Normalizer, logger *zap.Logger) *Crawl {
+	return &Crawl{
+		repo:       repo,
+		urls:       urls,
+		normalizer: normalizer,
+		logger:     logger,
+	}
+}
+
+// Create stores a new queued crawl, zero limits and scope use the defaults
+func (c *Crawl) Create(ctx context.Context, crawl internal.Crawl) (internal.Crawl, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Create")
+	defer span.End()
+
+	if crawl.Scope == "" {
+		crawl.Scope = internal.CrawlScopeHost
+	}
+
+	if crawl.MaxDepth == 0 {
+		crawl.MaxDepth = defaultCrawlMaxDepth
+	}
+
+	if crawl.MaxPages == 0 {
+		crawl.MaxPages = defaultCrawlMaxPages
+	}
+
+	if err := crawl.Validate(); err != nil {
+		return internal.Crawl{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "crawl.Validate")
+	}
+
+	if _, err := c.normalizer.Normalize(crawl.URL); err != nil {
+		return internal.Crawl{}, fmt.Errorf("normalizer normalize: %w", err)
+	}
+
+	crawl, err := c.repo.Create(ctx, crawl)
+	if err != nil {
+		return internal.Crawl{}, fmt.Errorf("repo create: %w", err)
+	}
+
+	return crawl, nil
+}
+
+// Delete removes an existing crawl, the analyses of its pages are kept
+func (c *Crawl) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Delete")
+	defer span.End()
+
+	if err := c.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("repo delete: %w", err)
+	}
+
+	return nil
+}
+
+// Find gets an existing crawl from the datastore along with the pages crawled so far
+func (c *Crawl) Find(ctx context.Context, id string) (internal.Crawl, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.Find")
+	defer span.End()
+
+	crawl, err := c.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Crawl{}, fmt.Errorf("repo find: %w", err)
+	}
+
+	return crawl, nil
+}
+
+// Run starts the worker pool claiming queued crawls, it blocks until ctx is cancelled and all workers
+// finished their current crawl.
+func (c *Crawl) Run(ctx context.Context, workers int, pollInterval time.Duration) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				if c.process(ctx) {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(pollInterval):
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// process claims and runs one crawl, it returns false when there was nothing to do
+func (c *Crawl) process(ctx context.Context) bool {
+	crawl, err := c.repo.Claim(ctx, time.Now().Add(-crawlStaleAfter))
+	if err != nil {
+		var ierr *internal.Error
+		if ctx.Err() == nil && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound) {
+			c.logger.Error("Couldn't claim crawl", zap.Error(err))
+		}
+
+		return false
+	}
+
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("CrawlTracer").Start(ctx, "Crawl.process")
+	defer span.End()
+
+	if err := c.crawl(ctx, crawl); err != nil {
+		if ctx.Err() != nil {
+			// shutting down, the crawl is claimed again once it is stale
+			return false
+		}
+
+		span.RecordError(err)
+
+		if err := c.repo.Fail(ctx, crawl.ID, err.Error()); err != nil {
+			c.logger.Error("Couldn't fail crawl", zap.String("id", crawl.ID), zap.Error(err))
+		}
+
+		return true
+	}
+
+	if err := c.repo.Complete(ctx, crawl.ID); err != nil {
+		c.logger.Error("Couldn't complete crawl", zap.String("id", crawl.ID), zap.Error(err))
+	}
+
+	return true
+}
+
+// crawlTarget is a page waiting to be analyzed
+type crawlTarget struct {
+	URL   string
+	Depth int
+}
+
+// crawl analyzes the pages breadth first from the seed, following the accessible links in scope of every
+// page not deeper than the max depth. Pages failing to be analyzed are recorded, the crawl only fails when
+// the seed does.
+func (c *Crawl) crawl(ctx context.Context, crawl internal.Crawl) error {
+	seed, err := c.normalizer.Normalize(crawl.URL)
+	if err != nil {
+		return fmt.Errorf("normalizer normalize: %w", err)
+	}
+
+	seedURL, err := url.Parse(seed)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid URL")
+	}
+
+	queue := []crawlTarget{{URL: seed}}
+	seen := map[string]bool{seed: true}
+
+	for position := 0; len(queue) > 0 && position < crawl.MaxPages; position++ {
+		target := queue[0]
+		queue = queue[1:]
+
+		page, links, err := c.visit(ctx, target)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err := c.repo.AddPage(ctx, crawl.ID, position, page); err != nil {
+			return fmt.Errorf("repo add page: %w", err)
+		}
+
+		if err != nil {
+			if position == 0 {
+				return err
+			}
+
+			continue
+		}
+
+		if target.Depth >= crawl.MaxDepth {
+			continue
+		}
+
+		for _, link := range links {
+			// broken links are already counted by the page linking them
+			if link.StatusClass() != internal.LinkStatusClass2xx {
+				continue
+			}
+
+			normalizedURL, err := c.normalizer.Normalize(link.ResolvedURL)
+			if err != nil || seen[normalizedURL] {
+				continue
+			}
+
+			linkURL, err := url.Parse(normalizedURL)
+			if err != nil || !inCrawlScope(crawl.Scope, seedURL, linkURL) {
+				continue
+			}
+
+			seen[normalizedURL] = true
+			queue = append(queue, crawlTarget{URL: normalizedURL, Depth: target.Depth + 1})
+		}
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(attribute.Int("crawl.discovered", len(seen)))
+
+	return nil
+}
+
+// visit analyzes the page and returns its checked links, the page records the error when it fails
+func (c *Crawl) visit(ctx context.Context, target crawlTarget) (internal.CrawlPage, []internal.Link, error) {
+	page := internal.CrawlPage{
+		URL:   target.URL,
+		Depth: target.Depth,
+	}
+
+	res, err := c.urls.Search(ctx, internal.SearchParams{URL: target.URL})
+	if err != nil {
+		page.Error = err.Error()
+		return page, nil, fmt.Errorf("urls search: %w", err)
+	}
+
+	page.URLID = res.URL.ID
+	page.PageTitle = res.URL.PageTitle
+	page.InaccessibleLinksCount = res.URL.InaccessibleLinksCount
+	page.HaveLoginForm = res.URL.HaveLoginForm
+
+	links, err := c.urls.FindLinks(ctx, res.URL.ID, "")
+	if err != nil {
+		return page, nil, fmt.Errorf("urls find links: %w", err)
+	}
+
+	return page, links, nil
+}
+
+// inCrawlScope indicates whether the crawl started from seed follows the link to target, both URLs are
+// expected to be normalized
+func inCrawlScope(scope internal.CrawlScope, seed, target *url.URL) bool {
+	if target.Host != seed.Host {
+		return false
+	}
+
+	if target.Scheme != "http" && target.Scheme != "https" {
+		return false
+	}
+
+	if scope != internal.CrawlScopePathPrefix {
+		return true
+	}
+
+	prefix := seed.Path[:strings.LastIndex(seed.Path, "/")+1]
+
+	return strings.HasPrefix(target.Path, prefix)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestInCrawlScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		scope    internal.CrawlScope
+		seed     string
+		target   string
+		expected bool
+	}{
+		{"OK: host", internal.CrawlScopeHost, "https://example.com/docs/intro", "https://example.com/blog/", true},
+		{"OK: host other scheme", internal.CrawlScopeHost, "https://example.com/", "http://example.com/about", true},
+		{"OK: path prefix", internal.CrawlScopePathPrefix, "https://example.com/docs/intro", "https://example.com/docs/setup", true},
+		{"OK: path prefix root", internal.CrawlScopePathPrefix, "https://example.com/", "https://example.com/blog/", true},
+		{"ERR: host subdomain", internal.CrawlScopeHost, "https://example.com/", "https://blog.example.com/", false},
+		{"ERR: host port", internal.CrawlScopeHost, "https://example.com/", "https://example.com:8443/", false},
+		{"ERR: path prefix", internal.CrawlScopePathPrefix, "https://example.com/docs/intro", "https://example.com/blog/", false},
+		{"ERR: path prefix sibling", internal.CrawlScopePathPrefix, "https://example.com/docs/", "https://example.com/docs-old/", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			seed, _ := url.Parse(tt.seed)
+			target, _ := url.Parse(tt.target)
+
+			if actual := inCrawlScope(tt.scope, seed, target); actual != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
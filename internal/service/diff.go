@@ -0,0 +1,92 @@
+package service
+
+import (
+	"reflect"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// diffURLs compares two analyses of the same page field by field, along with their checked links
+func diffURLs(from, to internal.URL, fromLinks, toLinks []internal.Link) internal.URLDiff {
+	diff := internal.URLDiff{
+		From: from,
+		To:   to,
+	}
+
+	fields := []struct {
+		name     string
+		from, to interface{}
+	}{
+		{"finalURL", from.FinalURL, to.FinalURL},
+		{"redirects", from.Redirects, to.Redirects},
+		{"statusCode", from.StatusCode, to.StatusCode},
+		{"contentType", from.ContentType, to.ContentType},
+		{"HTMLVersion", from.HTMLVersion, to.HTMLVersion},
+		{"pageTitle", from.PageTitle, to.PageTitle},
+		{"headings", from.Headings, to.Headings},
+		{"linksCount", from.LinksCount, to.LinksCount},
+		{"inaccessibleLinksCount", from.InaccessibleLinksCount, to.InaccessibleLinksCount},
+		{"internalLinksCount", from.InternalLinksCount, to.InternalLinksCount},
+		{"externalLinksCount", from.ExternalLinksCount, to.ExternalLinksCount},
+		{"haveLoginForm", from.HaveLoginForm, to.HaveLoginForm},
+		{"forms", from.Forms, to.Forms},
+	}
+
+	for _, field := range fields {
+		if !equalValues(field.from, field.to) {
+			diff.Changes = append(diff.Changes, internal.FieldChange{
+				Field: field.name,
+				From:  field.from,
+				To:    field.to,
+			})
+		}
+	}
+
+	broken := make(map[string]bool, len(fromLinks))
+	for _, link := range fromLinks {
+		if !link.Skipped {
+			broken[linkKey(link)] = link.Broken()
+		}
+	}
+
+	seen := make(map[string]bool, len(toLinks))
+
+	for _, link := range toLinks {
+		key := linkKey(link)
+		if link.Skipped || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		wasBroken, found := broken[key]
+
+		switch {
+		case link.Broken() && (!found || !wasBroken):
+			diff.NewBrokenLinks = append(diff.NewBrokenLinks, link)
+		case !link.Broken() && found && wasBroken:
+			diff.FixedLinks = append(diff.FixedLinks, link)
+		}
+	}
+
+	return diff
+}
+
+// equalValues compares the field values, nil and empty slices are considered equal
+func equalValues(a, b interface{}) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.Slice && vb.Kind() == reflect.Slice && va.Len() == 0 && vb.Len() == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+// linkKey identifies a link across analyses, by its target when it could be resolved
+func linkKey(link internal.Link) string {
+	if link.ResolvedURL != "" {
+		return link.ResolvedURL
+	}
+
+	return link.Href
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDiffURLs(t *testing.T) {
+	t.Parallel()
+
+	from := internal.URL{
+		ID:         "1-2-3",
+		StatusCode: 200,
+		PageTitle:  "Before",
+		Headings:   internal.Headings{H1: 1, Outline: []internal.Heading{{Level: 1, Text: "Before"}}},
+		LinksCount: 3,
+		Forms:      nil,
+	}
+
+	to := internal.URL{
+		ID:            "4-5-6",
+		StatusCode:    200,
+		PageTitle:     "After",
+		Headings:      internal.Headings{H1: 1, Outline: []internal.Heading{{Level: 1, Text: "After"}}},
+		LinksCount:    3,
+		HaveLoginForm: true,
+		Forms:         []internal.Form{{Kind: internal.FormKindLogin, Method: "post"}},
+		Redirects:     []internal.Redirect{},
+	}
+
+	fromLinks := []internal.Link{
+		{Href: "/a", ResolvedURL: "https://example.com/a", StatusCode: 200},
+		{Href: "/b", ResolvedURL: "https://example.com/b", StatusCode: 404},
+		{Href: "/c", ResolvedURL: "https://example.com/c", StatusCode: 500},
+		{Href: "mailto:info@example.com", Skipped: true},
+	}
+
+	toLinks := []internal.Link{
+		{Href: "/a", ResolvedURL: "https://example.com/a", StatusCode: 503},
+		{Href: "/b", ResolvedURL: "https://example.com/b", StatusCode: 200},
+		{Href: "/c", ResolvedURL: "https://example.com/c", StatusCode: 500},
+		{Href: "/d", ResolvedURL: "https://example.com/d", Error: "context deadline exceeded"},
+		{Href: "./d", ResolvedURL: "https://example.com/d", Error: "context deadline exceeded"},
+		{Href: "mailto:info@example.com", Skipped: true},
+	}
+
+	expected := internal.URLDiff{
+		From: from,
+		To:   to,
+		Changes: []internal.FieldChange{
+			{Field: "pageTitle", From: "Before", To: "After"},
+			{Field: "headings", From: from.Headings, To: to.Headings},
+			{Field: "haveLoginForm", From: false, To: true},
+			{Field: "forms", From: from.Forms, To: to.Forms},
+		},
+		NewBrokenLinks: []internal.Link{toLinks[0], toLinks[3]},
+		FixedLinks:     []internal.Link{toLinks[1]},
+	}
+
+	if actual := diffURLs(from, to, fromLinks, toLinks); !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+
+	if actual := diffURLs(from, from, fromLinks, fromLinks); len(actual.Changes) != 0 || len(actual.NewBrokenLinks) != 0 || len(actual.FixedLinks) != 0 {
+		t.Fatalf("expected no changes, got %+v", actual)
+	}
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const unknownHTMLVersion = "UNKNOWN"
+
+// doctypeVersions maps well known public identifiers to the version they declare
+var doctypeVersions = []struct {
+	publicID string
+	version  string
+}{
+	{"-//IETF//DTD HTML//EN", "HTML 2.0"},
+	{"-//IETF//DTD HTML 2.0//EN", "HTML 2.0"},
+	{"-//IETF//DTD HTML Level 2//EN", "HTML 2.0"},
+	{"-//IETF//DTD HTML 2.0 Level 2//EN", "HTML 2.0"},
+	{"-//IETF//DTD HTML Strict//EN", "HTML 2.0 Strict"},
+	{"-//IETF//DTD HTML 2.0 Strict//EN", "HTML 2.0 Strict"},
+	{"-//W3C//DTD HTML 3.2//EN", "HTML 3.2"},
+	{"-//W3C//DTD HTML 3.2 Final//EN", "HTML 3.2"},
+	{"-//W3C//DTD HTML 3.2 Draft//EN", "HTML 3.2"},
+	{"-//W3C//DTD HTML 4.0//EN", "HTML 4.0 Strict"},
+	{"-//W3C//DTD HTML 4.0 Transitional//EN", "HTML 4.0 Transitional"},
+	{"-//W3C//DTD HTML 4.0 Frameset//EN", "HTML 4.0 Frameset"},
+	{"-//W3C//DTD HTML 4.01//EN", "HTML 4.01 Strict"},
+	{"-//W3C//DTD HTML 4.01 Transitional//EN", "HTML 4.01 Transitional"},
+	{"-//W3C//DTD HTML 4.01 Frameset//EN", "HTML 4.01 Frameset"},
+	{"-//W3C//DTD XHTML 1.0 Strict//EN", "XHTML 1.0 Strict"},
+	{"-//W3C//DTD XHTML 1.0 Transitional//EN", "XHTML 1.0 Transitional"},
+	{"-//W3C//DTD XHTML 1.0 Frameset//EN", "XHTML 1.0 Frameset"},
+	{"-//W3C//DTD XHTML 1.1//EN", "XHTML 1.1"},
+	{"-//W3C//DTD XHTML Basic 1.0//EN", "XHTML Basic 1.0"},
+	{"-//W3C//DTD XHTML Basic 1.1//EN", "XHTML Basic 1.1"},
+	{"-//WAPFORUM//DTD XHTML Mobile 1.0//EN", "XHTML Mobile 1.0"},
+	{"-//WAPFORUM//DTD XHTML Mobile 1.1//EN", "XHTML Mobile 1.1"},
+	{"-//WAPFORUM//DTD XHTML Mobile 1.2//EN", "XHTML Mobile 1.2"},
+	{"-//OMA//DTD XHTML Mobile 1.2//EN", "XHTML Mobile 1.2"},
+	{"-//W3C//DTD XHTML 1.1 plus MathML 2.0//EN", "XHTML 1.1 plus MathML 2.0"},
+	{"-//W3C//DTD XHTML 1.1 plus MathML 2.0 plus SVG 1.1//EN", "XHTML 1.1 plus MathML 2.0 plus SVG 1.1"},
+	{"-//W3C//DTD MathML 2.0//EN", "MathML 2.0"},
+	{"-//W3C//DTD SVG 1.0//EN", "SVG 1.0"},
+	{"-//W3C//DTD SVG 1.1//EN", "SVG 1.1"},
+	{"-//W3C//DTD SVG 1.1 Basic//EN", "SVG 1.1 Basic"},
+	{"-//W3C//DTD SVG 1.1 Tiny//EN", "SVG 1.1 Tiny"},
+}
+
+// quirksPublicIDPrefixes are the public identifiers triggering quirks mode, as listed by the HTML standard
+var quirksPublicIDPrefixes = []string{
+	"+//silmaril//dtd html pro v0r11 19970101//",
+	"-//as//dtd html 3.0 aswedit + extensions//",
+	"-//advasoft ltd//dtd html 3.0 aswedit + extensions//",
+	"-//ietf//dtd html 2.0 level 1//",
+	"-//ietf//dtd html 2.0 level 2//",
+	"-//ietf//dtd html 2.0 strict level 1//",
+	"-//ietf//dtd html 2.0 strict level 2//",
+	"-//ietf//dtd html 2.0 strict//",
+	"-//ietf//dtd html 2.0//",
+	"-//ietf//dtd html 2.1e//",
+	"-//ietf//dtd html 3.0//",
+	"-//ietf//dtd html 3.2 final//",
+	"-//ietf//dtd html 3.2//",
+	"-//ietf//dtd html 3//",
+	"-//ietf//dtd html level 0//",
+	"-//ietf//dtd html level 1//",
+	"-//ietf//dtd html level 2//",
+	"-//ietf//dtd html level 3//",
+	"-//ietf//dtd html strict level 0//",
+	"-//ietf//dtd html strict level 1//",
+	"-//ietf//dtd html strict level 2//",
+	"-//ietf//dtd html strict level 3//",
+	"-//ietf//dtd html strict//",
+	"-//ietf//dtd html//",
+	"-//metrius//dtd metrius presentational//",
+	"-//microsoft//dtd internet explorer 2.0 html strict//",
+	"-//microsoft//dtd internet explorer 2.0 html//",
+	"-//microsoft//dtd internet explorer 2.0 tables//",
+	"-//microsoft//dtd internet explorer 3.0 html strict//",
+	"-//microsoft//dtd internet explorer 3.0 html//",
+	"-//microsoft//dtd internet explorer 3.0 tables//",
+	"-//netscape comm. corp.//dtd html//",
+	"-//netscape comm. corp.//dtd strict html//",
+	"-//o'reilly and associates//dtd html 2.0//",
+	"-//o'reilly and associates//dtd html extended 1.0//",
+	"-//o'reilly and associates//dtd html extended relaxed 1.0//",
+	"-//sq//dtd html 2.0 hotmetal + extensions//",
+	"-//softquad software//dtd hotmetal pro 6.0::19990601::extensions to html 4.0//",
+	"-//softquad//dtd hotmetal pro 4.0::19971010::extensions to html 4.0//",
+	"-//spyglass//dtd html 2.0 extended//",
+	"-//sun microsystems corp.//dtd hotjava html//",
+	"-//sun microsystems corp.//dtd hotjava strict html//",
+	"-//w3c//dtd html 3 1995-03-24//",
+	"-//w3c//dtd html 3.2 draft//",
+	"-//w3c//dtd html 3.2 final//",
+	"-//w3c//dtd html 3.2//",
+	"-//w3c//dtd html 3.2s draft//",
+	"-//w3c//dtd html 4.0 frameset//",
+	"-//w3c//dtd html 4.0 transitional//",
+	"-//w3c//dtd html experimental 19960712//",
+	"-//w3c//dtd html experimental 970421//",
+	"-//w3c//dtd w3 html//",
+	"-//w3o//dtd w3 html 3.0//",
+	"-//webtechs//dtd mozilla html 2.0//",
+	"-//webtechs//dtd mozilla html//",
+}
+
+// detectDoctype reads the doctype token of the raw document, only comments and whitespace may precede it
+func detectDoctype(body []byte) internal.Doctype {
+	z := html.NewTokenizer(bytes.NewReader(body))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken, html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			return internal.Doctype{Mode: internal.DocumentModeQuirks}
+		case html.TextToken:
+			if len(bytes.TrimLeft(z.Text(), "\ufeff \t\r\n\f")) > 0 {
+				return internal.Doctype{Mode: internal.DocumentModeQuirks}
+			}
+		case html.DoctypeToken:
+			doctype, forceQuirks := parseDoctype(string(z.Text()))
+			doctype.Mode = documentMode(doctype, forceQuirks)
+			return doctype
+		}
+	}
+}
+
+// parseDoctype splits the content of a doctype token into its name and identifiers, forceQuirks is set
+// for malformed declarations
+func parseDoctype(s string) (doctype internal.Doctype, forceQuirks bool) {
+	s = strings.TrimLeft(s, " \t\r\n\f")
+
+	i := strings.IndexAny(s, " \t\r\n\f")
+	if i == -1 {
+		i = len(s)
+	}
+
+	doctype.Name, s = strings.ToLower(s[:i]), strings.TrimLeft(s[i:], " \t\r\n\f")
+	if doctype.Name == "" {
+		return doctype, true
+	}
+
+	if len(s) < 6 {
+		return doctype, s != ""
+	}
+
+	keyword := strings.ToLower(s[:6])
+	if keyword != "public" && keyword != "system" {
+		return doctype, true
+	}
+
+	s = strings.TrimLeft(s[6:], " \t\r\n\f")
+
+	ids := make([]string, 0, 2)
+
+	for len(s) > 0 && (s[0] == '"' || s[0] == '\'') && len(ids) < 2 {
+		j := strings.IndexByte(s[1:], s[0])
+		if j == -1 {
+			return doctype, true
+		}
+
+		ids = append(ids, s[1:j+1])
+		s = strings.TrimLeft(s[j+2:], " \t\r\n\f")
+	}
+
+	switch {
+	case len(ids) == 0:
+		return doctype, true
+	case keyword == "system":
+		doctype.SystemID = ids[0]
+	default:
+		doctype.PublicID = ids[0]
+		if len(ids) == 2 {
+			doctype.SystemID = ids[1]
+		}
+	}
+
+	return doctype, false
+}
+
+// documentMode implements the quirks mode rules of the HTML standard for the doctype
+func documentMode(doctype internal.Doctype, forceQuirks bool) internal.DocumentMode {
+	publicID := strings.ToLower(doctype.PublicID)
+	systemID := strings.ToLower(doctype.SystemID)
+
+	if forceQuirks || doctype.Name != "html" ||
+		publicID == "-//w3o//dtd w3 html strict 3.0//en//" ||
+		publicID == "-/w3c/dtd html 4.0 transitional/en" ||
+		publicID == "html" ||
+		systemID == "http://www.ibm.com/data/dtd/v11/ibmxhtml1-transitional.dtd" {
+		return internal.DocumentModeQuirks
+	}
+
+	for _, prefix := range quirksPublicIDPrefixes {
+		if strings.HasPrefix(publicID, prefix) {
+			return internal.DocumentModeQuirks
+		}
+	}
+
+	legacy := strings.HasPrefix(publicID, "-//w3c//dtd html 4.01 frameset//") ||
+		strings.HasPrefix(publicID, "-//w3c//dtd html 4.01 transitional//")
+
+	switch {
+	case legacy && doctype.SystemID == "":
+		return internal.DocumentModeQuirks
+	case legacy,
+		strings.HasPrefix(publicID, "-//w3c//dtd xhtml 1.0 frameset//"),
+		strings.HasPrefix(publicID, "-//w3c//dtd xhtml 1.0 transitional//"):
+		return internal.DocumentModeLimitedQuirks
+	}
+
+	return internal.DocumentModeNoQuirks
+}
+
+// htmlVersion names the version declared by the doctype
+func htmlVersion(doctype internal.Doctype) string {
+	if doctype.Name == "" {
+		return unknownHTMLVersion
+	}
+
+	if doctype.PublicID == "" {
+		if doctype.Mode == internal.DocumentModeNoQuirks && (doctype.SystemID == "" || strings.EqualFold(doctype.SystemID, "about:legacy-compat")) {
+			return "HTML 5"
+		}
+		return unknownHTMLVersion
+	}
+
+	publicID := strings.Join(strings.Fields(doctype.PublicID), " ")
+
+	for _, v := range doctypeVersions {
+		if strings.EqualFold(publicID, v.publicID) {
+			return v.version
+		}
+	}
+
+	return unknownHTMLVersion
+}
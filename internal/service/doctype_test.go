@@ -0,0 +1,107 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDetectDoctype(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		input           string
+		expected        internal.Doctype
+		expectedVersion string
+	}{
+		{
+			"HTML 5",
+			"\ufeff<!-- generated -->\n<!doctype HTML><html><head><title>x</title></head></html>",
+			internal.Doctype{Name: "html", Mode: internal.DocumentModeNoQuirks},
+			"HTML 5",
+		},
+		{
+			"HTML 5 legacy compat",
+			`<!DOCTYPE html SYSTEM "about:legacy-compat"><html></html>`,
+			internal.Doctype{Name: "html", SystemID: "about:legacy-compat", Mode: internal.DocumentModeNoQuirks},
+			"HTML 5",
+		},
+		{
+			"HTML 4.01 Transitional without system identifier",
+			`<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN"><html></html>`,
+			internal.Doctype{Name: "html", PublicID: "-//W3C//DTD HTML 4.01 Transitional//EN", Mode: internal.DocumentModeQuirks},
+			"HTML 4.01 Transitional",
+		},
+		{
+			"HTML 4.01 Transitional",
+			`<!DOCTYPE HTML PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN" "http://www.w3.org/TR/html4/loose.dtd">`,
+			internal.Doctype{
+				Name:     "html",
+				PublicID: "-//W3C//DTD HTML 4.01 Transitional//EN",
+				SystemID: "http://www.w3.org/TR/html4/loose.dtd",
+				Mode:     internal.DocumentModeLimitedQuirks,
+			},
+			"HTML 4.01 Transitional",
+		},
+		{
+			"HTML 3.2",
+			`<!DOCTYPE HTML PUBLIC '-//W3C//DTD HTML 3.2 Final//EN'>`,
+			internal.Doctype{Name: "html", PublicID: "-//W3C//DTD HTML 3.2 Final//EN", Mode: internal.DocumentModeQuirks},
+			"HTML 3.2",
+		},
+		{
+			"XHTML Mobile",
+			`<?xml version="1.0"?><!DOCTYPE html PUBLIC "-//WAPFORUM//DTD XHTML Mobile 1.2//EN" "http://www.openmobilealliance.org/tech/DTD/xhtml-mobile12.dtd">`,
+			internal.Doctype{
+				Name:     "html",
+				PublicID: "-//WAPFORUM//DTD XHTML Mobile 1.2//EN",
+				SystemID: "http://www.openmobilealliance.org/tech/DTD/xhtml-mobile12.dtd",
+				Mode:     internal.DocumentModeNoQuirks,
+			},
+			"XHTML Mobile 1.2",
+		},
+		{
+			"SVG",
+			`<!DOCTYPE svg PUBLIC "-//W3C//DTD SVG 1.1//EN" "http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd">`,
+			internal.Doctype{
+				Name:     "svg",
+				PublicID: "-//W3C//DTD SVG 1.1//EN",
+				SystemID: "http://www.w3.org/Graphics/SVG/1.1/DTD/svg11.dtd",
+				Mode:     internal.DocumentModeQuirks,
+			},
+			"SVG 1.1",
+		},
+		{
+			"missing",
+			"<html><body><!DOCTYPE html></body></html>",
+			internal.Doctype{Mode: internal.DocumentModeQuirks},
+			"UNKNOWN",
+		},
+		{
+			"malformed",
+			`<!DOCTYPE html PUBLIC>`,
+			internal.Doctype{Name: "html", Mode: internal.DocumentModeQuirks},
+			"UNKNOWN",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := detectDoctype([]byte(tt.input))
+			if !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual))
+			}
+
+			if version := htmlVersion(actual); version != tt.expectedVersion {
+				t.Fatalf("expected version %q, got %q", tt.expectedVersion, version)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const (
+	defaultFetcherConnectTimeout = 5 * time.Second
+	defaultFetcherTimeout        = 30 * time.Second
+	defaultFetcherUserAgent      = "url-info/1.0 (+https://github.com/Oguzyildirim/url-info)"
+	defaultFetcherMaxBodyBytes   = 5 << 20
+	defaultFetcherMaxRedirects   = 10
+)
+
+var defaultFetcherAllowedSchemes = []string{"http", "https"}
+
+// FetcherConfig defines how pages are fetched, zero values use the defaults
+type FetcherConfig struct {
+	// ConnectTimeout is the maximum time spent establishing the connection
+	ConnectTimeout time.Duration
+	// Timeout is the maximum time spent on the whole fetch, redirects and body included
+	Timeout   time.Duration
+	UserAgent string
+	// MaxBodyBytes is the maximum size of the response body, larger bodies fail the fetch
+	MaxBodyBytes int64
+	// MaxRedirects is the maximum number of redirects followed, negative values disable redirects
+	MaxRedirects int
+	// AllowedSchemes lists the schemes of the requested URL and of the redirect targets
+	AllowedSchemes []string
+}
+
+// MaxConnectDuration returns the longest time establishing a connection takes with the config, the default
+// applied
+func (c FetcherConfig) MaxConnectDuration() time.Duration {
+	if c.ConnectTimeout <= 0 {
+		return defaultFetcherConnectTimeout
+	}
+
+	return c.ConnectTimeout
+}
+
+// MaxDuration returns the longest time a fetch takes with the config, the defaults applied
+func (c FetcherConfig) MaxDuration() time.Duration {
+	if c.Timeout <= 0 {
+		return defaultFetcherTimeout
+	}
+
+	return c.Timeout
+}
+
+// FetchResult is the response to fetching a page
+type FetchResult struct {
+	// URL is the URL of the final response, after following the redirects
+	URL        *url.URL
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+	Redirects  []internal.Redirect
+	// Duration is the time spent on the requests of the redirect chain, the politeness waits excluded
+	Duration time.Duration
+	// FetchedAt is when the request of the final response was sent
+	FetchedAt time.Time
+	// TLS is the state of the connection of the final response, nil when it's not HTTPS
+	TLS *tls.ConnectionState
+}
+
+// Fetcher retrieves the pages to analyze
+type Fetcher struct {
+	client         *http.Client
+	politeness     *Politeness
+	userAgent      string
+	maxBodyBytes   int64
+	maxRedirects   int
+	allowedSchemes map[string]bool
+}
+
+// NewFetcher instantiates a fetcher connecting only to the addresses allowed by guard, every request
+// including redirects is subject to politeness
+func NewFetcher(guard *Guard, politeness *Politeness, config FetcherConfig) *Fetcher {
+	config.ConnectTimeout = config.MaxConnectDuration()
+
+	if config.Timeout <= 0 {
+		config.Timeout = defaultFetcherTimeout
+	}
+
+	config.UserAgent = strings.TrimSpace(config.UserAgent)
+	if config.UserAgent == "" {
+		config.UserAgent = defaultFetcherUserAgent
+	}
+
+	if config.MaxBodyBytes <= 0 {
+		config.MaxBodyBytes = defaultFetcherMaxBodyBytes
+	}
+
+	if config.MaxRedirects == 0 {
+		config.MaxRedirects = defaultFetcherMaxRedirects
+	}
+
+	if len(config.AllowedSchemes) == 0 {
+		config.AllowedSchemes = defaultFetcherAllowedSchemes
+	}
+
+	allowedSchemes := make(map[string]bool, len(config.AllowedSchemes))
+	for _, scheme := range config.AllowedSchemes {
+		allowedSchemes[strings.ToLower(strings.TrimSpace(scheme))] = true
+	}
+
+	return &Fetcher{
+		client: &http.Client{
+			Transport: guard.Transport(config.ConnectTimeout),
+			Timeout:   config.Timeout,
+		},
+		politeness:     politeness,
+		userAgent:      config.UserAgent,
+		maxBodyBytes:   config.MaxBodyBytes,
+		maxRedirects:   config.MaxRedirects,
+		allowedSchemes: allowedSchemes,
+	}
+}
+
+// Fetch requests the URL following redirects, the result holds the final response whatever its status code
+func (f *Fetcher) Fetch(ctx context.Context, rawURL string) (FetchResult, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "Fetcher.Fetch")
+	defer span.End()
+
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return FetchResult{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid URL")
+	}
+
+	if err := f.checkScheme(target); err != nil {
+		return FetchResult{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return FetchResult{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid URL")
+	}
+
+	req.Header.Set("User-Agent", f.userAgent)
+
+	var (
+		redirects []internal.Redirect
+		fetchedAt time.Time
+		waited    time.Duration
+	)
+
+	// the client is copied so the redirect chain is recorded per fetch, the transport is still shared
+	client := *f.client
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if f.maxRedirects < 0 {
+			return http.ErrUseLastResponse
+		}
+
+		redirects = append(redirects, internal.Redirect{
+			URL:        via[len(via)-1].URL.String(),
+			StatusCode: req.Response.StatusCode,
+		})
+
+		// the redirect chain is up to the fetched server, not the caller
+		if len(via) > f.maxRedirects {
+			return internal.NewErrorf(internal.ErrorCodeUpstreamUnreachable, "stopped after %d redirects", f.maxRedirects)
+		}
+
+		if err := f.checkScheme(req.URL); err != nil {
+			return err
+		}
+
+		waitStart := time.Now()
+
+		if err := f.wait(req.Context(), req.URL); err != nil {
+			return err
+		}
+
+		fetchedAt = time.Now()
+		waited += fetchedAt.Sub(waitStart)
+
+		return nil
+	}
+
+	if err := f.wait(ctx, target); err != nil {
+		return FetchResult{}, upstreamError(err, "wait")
+	}
+
+	start := time.Now()
+	fetchedAt = start
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return FetchResult{}, upstreamError(err, "request")
+	}
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.maxBodyBytes+1))
+	if err != nil {
+		return FetchResult{}, upstreamError(err, "read body")
+	}
+
+	if int64(len(body)) > f.maxBodyBytes {
+		return FetchResult{}, internal.NewErrorf(internal.ErrorCodeTooLarge, "response body exceeds %d bytes", f.maxBodyBytes)
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode), attribute.Int("redirects.count", len(redirects)))
+
+	return FetchResult{
+		URL:        resp.Request.URL,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       body,
+		Redirects:  redirects,
+		Duration:   time.Since(start) - waited,
+		FetchedAt:  fetchedAt,
+		TLS:        resp.TLS,
+	}, nil
+}
+
+// upstreamError classifies the failure to reach the fetched server, errors raised by the guard or the
+// redirect policy are kept as is
+func upstreamError(err error, msg string) error {
+	var (
+		ierr    *internal.Error
+		certErr x509.CertificateInvalidError
+	)
+
+	switch {
+	case errors.As(err, &ierr):
+		return ierr
+	case isTimeout(err):
+		return internal.WrapErrorf(err, internal.ErrorCodeTimeout, msg)
+	case errors.As(err, &certErr) && certErr.Reason == x509.Expired:
+		return internal.WrapErrorf(err, internal.ErrorCodeUpstreamUnreachable, "certificate of %s expired at %s",
+			certErr.Cert.Subject.CommonName, certErr.Cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+
+	return internal.WrapErrorf(err, internal.ErrorCodeUpstreamUnreachable, msg)
+}
+
+// wait returns an error when robots.txt disallows target, otherwise it waits for the host to accept the request
+func (f *Fetcher) wait(ctx context.Context, target *url.URL) error {
+	if err := f.politeness.Allowed(ctx, target); err != nil {
+		return err
+	}
+
+	return f.politeness.Wait(ctx, target)
+}
+
+func (f *Fetcher) checkScheme(target *url.URL) error {
+	if !f.allowedSchemes[strings.ToLower(target.Scheme)] {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unsupported scheme %q", target.Scheme)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package service_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/service"
+)
+
+func TestFetcher_Fetch(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/old":
+			time.Sleep(20 * time.Millisecond)
+			http.Redirect(w, r, "/moved", http.StatusMovedPermanently)
+		case "/moved":
+			http.Redirect(w, r, "/page", http.StatusFound)
+		case "/page":
+			w.Write([]byte(r.UserAgent()))
+		case "/loop":
+			http.Redirect(w, r, "/loop", http.StatusFound)
+		case "/ftp":
+			http.Redirect(w, r, "ftp://example.com/file", http.StatusFound)
+		case "/large":
+			w.Write([]byte(strings.Repeat("a", 64)))
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	guard, err := service.NewGuard(service.GuardConfig{
+		AllowedCIDRs: []string{"127.0.0.0/8", "::1/128"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	fetcher := service.NewFetcher(guard, nil, service.FetcherConfig{
+		UserAgent:    "test-agent",
+		MaxBodyBytes: 32,
+		MaxRedirects: 3,
+		Timeout:      100 * time.Millisecond,
+	})
+
+	t.Run("OK: redirect chain", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Now()
+
+		res, err := fetcher.Fetch(context.Background(), srv.URL+"/old")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		// the final request is sent after the redirect of /old
+		if res.FetchedAt.Before(start.Add(20*time.Millisecond)) || res.FetchedAt.After(time.Now()) {
+			t.Fatalf("expected fetch time of the final request, got %s for a fetch started at %s", res.FetchedAt, start)
+		}
+
+		if res.URL.String() != srv.URL+"/page" || res.StatusCode != http.StatusOK {
+			t.Fatalf("expected %s 200, got %s %d", srv.URL+"/page", res.URL, res.StatusCode)
+		}
+
+		if string(res.Body) != "test-agent" {
+			t.Fatalf("expected user agent to be sent, got %q", res.Body)
+		}
+
+		expected := []internal.Redirect{
+			{URL: srv.URL + "/old", StatusCode: http.StatusMovedPermanently},
+			{URL: srv.URL + "/moved", StatusCode: http.StatusFound},
+		}
+
+		if !cmp.Equal(expected, res.Redirects) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res.Redirects))
+		}
+	})
+
+	for _, tt := range []struct {
+		name string
+		path string
+		code internal.ErrorCode
+	}{
+		{"ERR: too many redirects", "/loop", internal.ErrorCodeUpstreamUnreachable},
+		{"ERR: redirect scheme", "/ftp", internal.ErrorCodeInvalidArgument},
+		{"ERR: body too large", "/large", internal.ErrorCodeTooLarge},
+		{"ERR: timeout", "/slow", internal.ErrorCodeTimeout},
+	} {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := fetcher.Fetch(context.Background(), srv.URL+tt.path)
+
+			var ierr *internal.Error
+			if !errors.As(err, &ierr) || ierr.Code() != tt.code {
+				t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+			}
+		})
+	}
+
+	t.Run("ERR: unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		closed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		closed.Close()
+
+		_, err := fetcher.Fetch(context.Background(), closed.URL)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeUpstreamUnreachable {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+
+	t.Run("ERR: expired certificate", func(t *testing.T) {
+		t.Parallel()
+
+		expired := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		expired.TLS = &tls.Config{Certificates: []tls.Certificate{newExpiredCertificate(t)}}
+		expired.StartTLS()
+		t.Cleanup(expired.Close)
+
+		_, err := fetcher.Fetch(context.Background(), expired.URL)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeUpstreamUnreachable {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+
+		if !strings.HasPrefix(err.Error(), "certificate of 127.0.0.1 expired at ") {
+			t.Fatalf("expected the certificate expiry to be reported, got %s", err)
+		}
+	})
+
+	t.Run("ERR: scheme", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := fetcher.Fetch(context.Background(), "file:///etc/passwd")
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
+
+// newExpiredCertificate returns a self-signed certificate for 127.0.0.1 that expired yesterday
+func newExpiredCertificate(t *testing.T) tls.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:    time.Now().Add(-48 * time.Hour),
+		NotAfter:     time.Now().Add(-24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
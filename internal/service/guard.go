@@ -0,0 +1,144 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// defaultDeniedCIDRs are the loopback, link-local, private and otherwise non public ranges
+var defaultDeniedCIDRs = []string{
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"224.0.0.0/4",
+	"240.0.0.0/4",
+	"::/128",
+	"::1/128",
+	"64:ff9b::/96",
+	"fc00::/7",
+	"fe80::/10",
+	"ff00::/8",
+}
+
+// GuardConfig defines the addresses outgoing requests may not connect to
+type GuardConfig struct {
+	// DeniedCIDRs are denied on top of the default ranges
+	DeniedCIDRs []string
+	// AllowedCIDRs are allowed even when part of a denied range
+	AllowedCIDRs []string
+}
+
+// Guard prevents the server from being used to reach internal addresses
+type Guard struct {
+	denied  []*net.IPNet
+	allowed []*net.IPNet
+}
+
+// NewGuard
+func NewGuard(config GuardConfig) (*Guard, error) {
+	denied, err := parseCIDRs(append(append([]string{}, defaultDeniedCIDRs...), config.DeniedCIDRs...))
+	if err != nil {
+		return nil, fmt.Errorf("denied: %w", err)
+	}
+
+	allowed, err := parseCIDRs(config.AllowedCIDRs)
+	if err != nil {
+		return nil, fmt.Errorf("allowed: %w", err)
+	}
+
+	return &Guard{
+		denied:  denied,
+		allowed: allowed,
+	}, nil
+}
+
+// Check returns an error when the ip is not allowed
+func (g *Guard) Check(ip net.IP) error {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+
+	for _, n := range g.allowed {
+		if n.Contains(ip) {
+			return nil
+		}
+	}
+
+	for _, n := range g.denied {
+		if n.Contains(ip) {
+			return internal.NewErrorf(internal.ErrorCodeForbiddenTarget, "address %s is not allowed", ip)
+		}
+	}
+
+	return nil
+}
+
+// Dialer returns a dialer refusing connections to addresses not allowed, the check happens on the resolved
+// address being connected to so DNS rebinding can't bypass it
+func (g *Guard) Dialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return internal.NewErrorf(internal.ErrorCodeForbiddenTarget, "address %s is not an IP", host)
+			}
+
+			return g.Check(ip)
+		},
+	}
+}
+
+// Transport returns a transport connecting through the guarded dialer, proxies are not used because the
+// proxy would be dialed instead of the target. connectTimeout bounds both the dial and the TLS handshake, the
+// default of the fetcher applies when it's not set.
+func (g *Guard) Transport(connectTimeout time.Duration) *http.Transport {
+	if connectTimeout <= 0 {
+		connectTimeout = defaultFetcherConnectTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = g.Dialer(connectTimeout).DialContext
+	transport.TLSHandshakeTimeout = connectTimeout
+
+	return transport
+}
+
+func parseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	res := make([]*net.IPNet, 0, len(cidrs))
+
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, n)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/service"
+)
+
+func TestGuard_Check(t *testing.T) {
+	t.Parallel()
+
+	guard, err := service.NewGuard(service.GuardConfig{
+		DeniedCIDRs:  []string{"203.0.113.0/24"},
+		AllowedCIDRs: []string{"10.1.0.0/16"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	tests := []struct {
+		ip      string
+		withErr bool
+	}{
+		{"93.184.216.34", false},
+		{"2606:2800:220:1:248:1893:25c8:1946", false},
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"169.254.169.254", true},
+		{"::ffff:169.254.169.254", true},
+		{"192.168.1.10", true},
+		{"fd00::1", true},
+		{"0.0.0.0", true},
+		{"203.0.113.7", true},
+		{"10.1.2.3", false},
+		{"10.2.0.1", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.ip, func(t *testing.T) {
+			t.Parallel()
+
+			err := guard.Check(net.ParseIP(tt.ip))
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %v", tt.withErr, err)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeForbiddenTarget) {
+				t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+			}
+		})
+	}
+
+	if _, err := service.NewGuard(service.GuardConfig{DeniedCIDRs: []string{"10.0.0.0"}}); err == nil {
+		t.Fatalf("expected error, got not value")
+	}
+}
+
+func TestGuard_Transport(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(srv.Close)
+
+	guard, err := service.NewGuard(service.GuardConfig{})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	_, err = service.NewFetcher(guard, nil, service.FetcherConfig{}).Fetch(context.Background(), srv.URL)
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeForbiddenTarget {
+		t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+	}
+
+	transport := guard.Transport(0)
+	if transport.TLSHandshakeTimeout <= 0 {
+		t.Fatalf("expected a default TLS handshake timeout, got %s", transport.TLSHandshakeTimeout)
+	}
+
+	base, _ := url.Parse(srv.URL)
+
+	results := service.NewLinkChecker(&http.Client{Transport: transport}, nil, service.LinkCheckerConfig{}).
+		Check(context.Background(), base, []string{"/"})
+
+	if !errors.As(results[0].Err, &ierr) || ierr.Code() != internal.ErrorCodeForbiddenTarget {
+		t.Fatalf("expected %T error, got %T : %v", ierr, results[0].Err, results[0].Err)
+	}
+}
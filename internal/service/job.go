@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// jobStaleAfter is how long a job can stay running before another worker claims it again,
+// it covers workers that died in the middle of an analysis
+const jobStaleAfter = 10 * time.Minute
+
+// JobRepository defines the datastore handling persisting Job records
+type JobRepository interface {
+	Create(ctx context.Context, params internal.SearchParams) (internal.Job, error)
+	Claim(ctx context.Context, staleBefore time.Time) (internal.Job, error)
+	Complete(ctx context.Context, id string, URLID string) error
+	Fail(ctx context.Context, id string, msg string) error
+	Find(ctx context.Context, id string) (internal.Job, error)
+}
+
+// Job defines the application service in charge of running analysis jobs in the background
+type Job struct {
+	repo   JobRepository
+	urls   *URL
+	logger *zap.Logger
+}
+
+// NewJob
+func NewJob(repo JobRepository, urls *URL, logger *zap.Logger) *Job {
+	return &Job{
+		repo:   repo,
+		urls:   urls,
+		logger: logger,
+	}
+}
+
+// Find gets an existing Job from the datastore
+func (j *Job) Find(ctx context.Context, id string) (internal.Job, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("JobTracer").Start(ctx, "Job.Find")
+	defer span.End()
+
+	job, err := j.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Job{}, fmt.Errorf("repo find: %w", err)
+	}
+
+	return job, nil
+}
+
+// Run starts the worker pool claiming queued jobs, it blocks until ctx is cancelled and all workers
+// finished their current job.
+func (j *Job) Run(ctx context.Context, workers int, pollInterval time.Duration) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				if j.process(ctx) {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(pollInterval):
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// process claims and runs one job, it returns false when there was nothing to do
+func (j *Job) process(ctx context.Context) bool {
+	job, err := j.repo.Claim(ctx, time.Now().Add(-jobStaleAfter))
+	if err != nil {
+		var ierr *internal.Error
+		if ctx.Err() == nil && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound) {
+			j.logger.Error("Couldn't claim job", zap.Error(err))
+		}
+
+		return false
+	}
+
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("JobTracer").Start(ctx, "Job.process")
+	defer span.End()
+
+	params := internal.SearchParams{URL: job.URL, MaxAge: job.MaxAge}
+
+	// watches re-analyze the page on their schedule, a cached analysis would hide the changes
+	if job.WatchID != "" {
+		var fresh time.Duration
+		params.MaxAge = &fresh
+	}
+
+	res, err := j.urls.Search(ctx, params)
+	if err != nil {
+		if ctx.Err() != nil {
+			// shutting down, the job is claimed again once it is stale
+			return false
+		}
+
+		span.RecordError(err)
+
+		if err := j.repo.Fail(ctx, job.ID, err.Error()); err != nil {
+			j.logger.Error("Couldn't fail job", zap.String("id", job.ID), zap.Error(err))
+		}
+
+		return true
+	}
+
+	if err := j.repo.Complete(ctx, job.ID, res.URL.ID); err != nil {
+		j.logger.Error("Couldn't complete job", zap.String("id", job.ID), zap.Error(err))
+	}
+
+	return true
+}
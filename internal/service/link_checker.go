@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const (
+	defaultLinkCheckerConcurrency = 10
+	defaultLinkCheckerTimeout     = 5 * time.Second
+	defaultLinkCheckerDeadline    = 30 * time.Second
+)
+
+// LinkCheckerConfig defines how links are checked, zero values use the defaults
+type LinkCheckerConfig struct {
+	// Concurrency is the maximum number of links checked at the same time
+	Concurrency int
+	// Timeout is the maximum time spent on each request
+	Timeout time.Duration
+	// Deadline is the maximum time spent checking all the links of a page
+	Deadline  time.Duration
+	UserAgent string
+}
+
+// MaxDuration returns the longest time checking the links of a page takes with the config, the defaults
+// applied
+func (c LinkCheckerConfig) MaxDuration() time.Duration {
+	if c.Deadline <= 0 {
+		return defaultLinkCheckerDeadline
+	}
+
+	return c.Deadline
+}
+
+// LinkResult is the outcome of checking one link
+type LinkResult struct {
+	Href string
+	// URL is Href resolved against the page, empty when skipped for not being fetchable over HTTP
+	URL string
+	// Skipped is set for links that are not fetched: fragments, mailto:, tel:, javascript:, ... and links
+	// disallowed by robots.txt, the latter keep URL and report why in Err
+	Skipped    bool
+	StatusCode int
+	Err        error
+	Latency    time.Duration
+}
+
+// Accessible indicates whether the link was reached successfully, skipped links are considered accessible
+func (r LinkResult) Accessible() bool {
+	return r.Skipped || (r.Err == nil && r.StatusCode < http.StatusBadRequest)
+}
+
+// LinkChecker checks whether the links of a page can be reached
+type LinkChecker struct {
+	client      *http.Client
+	politeness  *Politeness
+	userAgent   string
+	concurrency int
+	timeout     time.Duration
+	deadline    time.Duration
+}
+
+// NewLinkChecker instantiates a link checker sharing politeness with the other requests to the analyzed sites
+func NewLinkChecker(client *http.Client, politeness *Politeness, config LinkCheckerConfig) *LinkChecker {
+	checker := &LinkChecker{
+		client:      client,
+		politeness:  politeness,
+		userAgent:   strings.TrimSpace(config.UserAgent),
+		concurrency: config.Concurrency,
+		timeout:     config.Timeout,
+		deadline:    config.Deadline,
+	}
+
+	if checker.concurrency <= 0 {
+		checker.concurrency = defaultLinkCheckerConcurrency
+	}
+
+	if checker.timeout <= 0 {
+		checker.timeout = defaultLinkCheckerTimeout
+	}
+
+	if checker.deadline <= 0 {
+		checker.deadline = defaultLinkCheckerDeadline
+	}
+
+	if checker.userAgent == "" {
+		checker.userAgent = defaultFetcherUserAgent
+	}
+
+	return checker
+}
+
+// Check resolves hrefs against base and checks each distinct URL once, results are returned in the same
+// order as hrefs. It returns once all links are checked or the deadline is reached, links not checked
+// by then fail with context.DeadlineExceeded.
+func (c *LinkChecker) Check(ctx context.Context, base *url.URL, hrefs []string) []LinkResult {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "LinkChecker.Check")
+	defer span.End()
+
+	ctx, cancel := context.WithTimeout(ctx, c.deadline)
+	defer cancel()
+
+	results := make([]LinkResult, len(hrefs))
+	indexes := make(map[string][]int)
+
+	var targets []string
+
+	for i, href := range hrefs {
+		results[i].Href = href
+
+		target, skip, err := resolveLink(base, href)
+		if skip {
+			results[i].Skipped = true
+			continue
+		}
+
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		results[i].URL = target
+
+		if _, ok := indexes[target]; !ok {
+			targets = append(targets, target)
+		}
+
+		indexes[target] = append(indexes[target], i)
+	}
+
+	span.SetAttributes(attribute.Int("links.count", len(hrefs)), attribute.Int("links.distinct", len(targets)))
+
+	checked := make([]LinkResult, len(targets))
+	sem := make(chan struct{}, c.concurrency)
+
+	var wg sync.WaitGroup
+
+	for i, target := range targets {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			checked[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+
+		go func(i int, target string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			checked[i] = c.check(ctx, target)
+		}(i, target)
+	}
+
+	wg.Wait()
+
+	for i, target := range targets {
+		for _, j := range indexes[target] {
+			results[j].Skipped = checked[i].Skipped
+			results[j].StatusCode = checked[i].StatusCode
+			results[j].Err = checked[i].Err
+			results[j].Latency = checked[i].Latency
+		}
+	}
+
+	return results
+}
+
+// check requests the target with HEAD, falling back to GET for servers not handling HEAD properly. Targets
+// disallowed by robots.txt are skipped.
+func (c *LinkChecker) check(ctx context.Context, target string) LinkResult {
+	if u, err := url.Parse(target); err == nil {
+		if err := c.politeness.Allowed(ctx, u); err != nil {
+			return LinkResult{Skipped: true, Err: err}
+		}
+	}
+
+	start := time.Now()
+
+	status, err := c.do(ctx, http.MethodHead, target)
+	if (err == nil && status >= http.StatusBadRequest) || (err != nil && !isTimeout(err) && !isForbidden(err) && ctx.Err() == nil) {
+		status, err = c.do(ctx, http.MethodGet, target)
+	}
+
+	return LinkResult{
+		StatusCode: status,
+		Err:        err,
+		Latency:    time.Since(start),
+	}
+}
+
+func (c *LinkChecker) do(ctx context.Context, method, target string) (int, error) {
+	req, err := http.NewRequest(method, target, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	// the time spent waiting for the host is not part of the request timeout
+	if err := c.politeness.Wait(ctx, req.URL); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req = req.WithContext(ctx)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
+
+// resolveLink returns the absolute URL of href without fragment, skip is set for links that can't be
+// fetched over HTTP
+func resolveLink(base *url.URL, href string) (target string, skip bool, err error) {
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") {
+		return "", true, nil
+	}
+
+	ref, err := url.Parse(href)
+	if err != nil {
+		if scheme := strings.ToLower(strings.SplitN(href, ":", 2)[0]); scheme == "javascript" {
+			return "", true, nil
+		}
+
+		return "", false, err
+	}
+
+	res := base.ResolveReference(ref)
+	if res.Scheme != "http" && res.Scheme != "https" {
+		return "", true, nil
+	}
+
+	res.Fragment = ""
+	res.RawFragment = ""
+
+	return res.String(), false, nil
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var nerr net.Error
+
+	return errors.As(err, &nerr) && nerr.Timeout()
+}
+
+func isForbidden(err error) bool {
+	var ierr *internal.Error
+
+	return errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeForbiddenTarget
+}
@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal/service"
+)
+
+func TestLinkChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		switch r.URL.Path {
+		case "/ok", "/docs/page":
+			w.WriteHeader(http.StatusOK)
+		case "/head-not-allowed":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	base, _ := url.Parse(srv.URL + "/docs/")
+
+	checker := service.NewLinkChecker(srv.Client(), nil, service.LinkCheckerConfig{
+		Concurrency: 2,
+		Timeout:     50 * time.Millisecond,
+	})
+
+	hrefs := []string{
+		srv.URL + "/ok",
+		"page#section",
+		"/missing",
+		"/head-not-allowed",
+		"/slow",
+		"#top",
+		"mailto:someone@example.com",
+		"tel:+123",
+		"javascript:void(0)",
+		"",
+		"/ok",
+	}
+
+	results := checker.Check(context.Background(), base, hrefs)
+
+	if len(results) != len(hrefs) {
+		t.Fatalf("expected %d results, got %d", len(hrefs), len(results))
+	}
+
+	expected := []struct {
+		URL        string
+		skipped    bool
+		accessible bool
+	}{
+		{srv.URL + "/ok", false, true},
+		{srv.URL + "/docs/page", false, true},
+		{srv.URL + "/missing", false, false},
+		{srv.URL + "/head-not-allowed", false, true},
+		{srv.URL + "/slow", false, false},
+		{"", true, true},
+		{"", true, true},
+		{"", true, true},
+		{"", true, true},
+		{"", true, true},
+		{srv.URL + "/ok", false, true},
+	}
+
+	for i, exp := range expected {
+		res := results[i]
+
+		if res.Href != hrefs[i] || res.URL != exp.URL || res.Skipped != exp.skipped || res.Accessible() != exp.accessible {
+			t.Errorf("%q: expected URL %q skipped %t accessible %t, got %+v", hrefs[i], exp.URL, exp.skipped, exp.accessible, res)
+		}
+	}
+
+	if max := atomic.LoadInt32(&maxInFlight); max > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", max)
+	}
+}
+
+func TestLinkChecker_Check_Deadline(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	t.Cleanup(srv.Close)
+
+	base, _ := url.Parse(srv.URL)
+
+	checker := service.NewLinkChecker(srv.Client(), nil, service.LinkCheckerConfig{
+		Concurrency: 1,
+		Deadline:    50 * time.Millisecond,
+	})
+
+	start := time.Now()
+
+	results := checker.Check(context.Background(), base, []string{"/a", "/b", "/c"})
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("expected checks to stop at the deadline, took %s", elapsed)
+	}
+
+	for _, res := range results {
+		if res.Accessible() || res.Err == nil {
+			t.Fatalf("expected deadline error, got %+v", res)
+		}
+	}
+}
+
+func TestLinkChecker_Check_Robots(t *testing.T) {
+	t.Parallel()
+
+	var requested int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+			return
+		}
+
+		if r.URL.Path == "/private" {
+			atomic.AddInt32(&requested, 1)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	base, _ := url.Parse(srv.URL)
+
+	checker := service.NewLinkChecker(srv.Client(),
+		service.NewPoliteness(srv.Client(), service.PolitenessConfig{}),
+		service.LinkCheckerConfig{})
+
+	results := checker.Check(context.Background(), base, []string{"/public", "/private"})
+
+	if !results[0].Accessible() || results[0].Skipped || results[0].StatusCode != http.StatusOK {
+		t.Fatalf("expected allowed link to be checked, got %+v", results[0])
+	}
+
+	if !results[1].Skipped || results[1].Err == nil || results[1].URL != srv.URL+"/private" {
+		t.Fatalf("expected disallowed link to be skipped, got %+v", results[1])
+	}
+
+	if n := atomic.LoadInt32(&requested); n != 0 {
+		t.Fatalf("expected disallowed link not to be requested, got %d requests", n)
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"mime"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// metadataAnalyzer reports the SEO metadata of the page as the metadata section
+type metadataAnalyzer struct{}
+
+func (metadataAnalyzer) Name() string { return "metadata" }
+
+func (metadataAnalyzer) Concurrent() bool { return true }
+
+func (a metadataAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	return NewSection(a.Name(), detectMetadata(page))
+}
+
+func detectMetadata(page *Page) internal.Metadata {
+	doc := page.Document
+
+	metadata := internal.Metadata{
+		Robots:     []string{},
+		XRobotsTag: []string{},
+		Hreflang:   []internal.Hreflang{},
+		Warnings:   []internal.MetadataWarning{},
+	}
+
+	titles := doc.Find("head title")
+
+	switch {
+	case titles.Length() == 0 || strings.TrimSpace(titles.First().Text()) == "":
+		metadata.Warnings = append(metadata.Warnings, internal.MetadataWarningTitleMissing)
+	case titles.Length() > 1:
+		metadata.Warnings = append(metadata.Warnings, internal.MetadataWarningTitleDuplicate)
+	}
+
+	descriptions := metaContents(doc, "description")
+
+	switch {
+	case len(descriptions) == 0 || descriptions[0] == "":
+		metadata.Warnings = append(metadata.Warnings, internal.MetadataWarningDescriptionMissing)
+	case len(descriptions) > 1:
+		metadata.Warnings = append(metadata.Warnings, internal.MetadataWarningDescriptionDuplicate)
+	}
+
+	if len(descriptions) > 0 {
+		metadata.Description = descriptions[0]
+		metadata.DescriptionLength = utf8.RuneCountInString(descriptions[0])
+	}
+
+	for _, content := range metaContents(doc, "robots") {
+		metadata.Robots = append(metadata.Robots, robotsDirectives(content)...)
+	}
+
+	for _, value := range page.Fetch.Header.Values("X-Robots-Tag") {
+		metadata.XRobotsTag = append(metadata.XRobotsTag, robotsDirectives(value)...)
+	}
+
+	if viewports := metaContents(doc, "viewport"); len(viewports) > 0 {
+		metadata.Viewport = viewports[0]
+	}
+
+	metadata.Charset = detectCharset(doc)
+
+	doc.Find("head link[rel][href]").Each(func(_ int, item *goquery.Selection) {
+		rel, _ := item.Attr("rel")
+		rels := strings.Fields(strings.ToLower(rel))
+		href, _ := item.Attr("href")
+
+		target := resolveReference(page.Base, href)
+		if target == nil {
+			return
+		}
+
+		if hasToken(rels, "canonical") && metadata.Canonical == "" {
+			metadata.Canonical = target.String()
+			metadata.CanonicalSelf = sameDocument(target, page.Fetch.URL)
+		}
+
+		if lang, ok := item.Attr("hreflang"); ok && hasToken(rels, "alternate") {
+			metadata.Hreflang = append(metadata.Hreflang, internal.Hreflang{
+				Lang: strings.TrimSpace(lang),
+				URL:  target.String(),
+			})
+		}
+	})
+
+	return metadata
+}
+
+// metaContents returns the trimmed content of the meta elements named name, names are case insensitive
+func metaContents(doc *goquery.Document, name string) []string {
+	var contents []string
+
+	doc.Find("meta[name]").Each(func(_ int, item *goquery.Selection) {
+		if value, _ := item.Attr("name"); strings.EqualFold(strings.TrimSpace(value), name) {
+			content, _ := item.Attr("content")
+			contents = append(contents, strings.TrimSpace(content))
+		}
+	})
+
+	return contents
+}
+
+// robotsDirectives splits the comma separated directives of a robots meta tag or X-Robots-Tag header
+func robotsDirectives(value string) []string {
+	var directives []string
+
+	for _, directive := range strings.Split(value, ",") {
+		if directive = strings.ToLower(strings.TrimSpace(directive)); directive != "" {
+			directives = append(directives, directive)
+		}
+	}
+
+	return directives
+}
+
+func detectCharset(doc *goquery.Document) string {
+	if charset, ok := doc.Find("meta[charset]").First().Attr("charset"); ok {
+		return strings.TrimSpace(charset)
+	}
+
+	var charset string
+
+	doc.Find("meta[http-equiv]").EachWithBreak(func(_ int, item *goquery.Selection) bool {
+		if value, _ := item.Attr("http-equiv"); !strings.EqualFold(strings.TrimSpace(value), "content-type") {
+			return true
+		}
+
+		content, _ := item.Attr("content")
+		if _, params, err := mime.ParseMediaType(content); err == nil {
+			charset = params["charset"]
+		}
+
+		return false
+	})
+
+	return charset
+}
+
+// resolveReference returns href resolved against base, nil when href is not a valid URL
+func resolveReference(base *url.URL, href string) *url.URL {
+	ref, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return nil
+	}
+
+	return base.ResolveReference(ref)
+}
+
+// sameDocument indicates whether both URLs point to the same document, fragments aside
+func sameDocument(a, b *url.URL) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	x, y := *a, *b
+	x.Fragment, x.RawFragment = "", ""
+	y.Fragment, y.RawFragment = "", ""
+
+	return x.String() == y.String()
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDetectMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		pageURL  string
+		header   http.Header
+		input    string
+		expected internal.Metadata
+	}{
+		{
+			"OK",
+			"https://example.com/docs/#intro",
+			http.Header{"X-Robots-Tag": []string{"noarchive", "googlebot: NoSnippet, nofollow"}},
+			`<html><head>
+<meta charset="UTF-8">
+<title>Docs</title>
+<meta name="Description" content=" Everything about the café ">
+<meta name="robots" content="noindex, FOLLOW">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<link rel="canonical" href="/docs/">
+<link rel="alternate" hreflang="de" href="https://example.de/docs/">
+<link rel="alternate stylesheet" href="/dark.css">
+</head><body><svg><title>Icon</title></svg></body></html>`,
+			internal.Metadata{
+				Description:       "Everything about the café",
+				DescriptionLength: 25,
+				Robots:            []string{"noindex", "follow"},
+				XRobotsTag:        []string{"noarchive", "googlebot: nosnippet", "nofollow"},
+				Canonical:         "https://example.com/docs/",
+				CanonicalSelf:     true,
+				Hreflang:          []internal.Hreflang{{Lang: "de", URL: "https://example.de/docs/"}},
+				Viewport:          "width=device-width, initial-scale=1",
+				Charset:           "UTF-8",
+				Warnings:          []internal.MetadataWarning{},
+			},
+		},
+		{
+			"OK: http-equiv charset and other canonical",
+			"https://example.com/docs/?page=2",
+			nil,
+			`<html><head>
+<meta http-equiv="Content-Type" content="text/html; charset=iso-8859-1">
+<title>Docs</title><title>Docs again</title>
+<meta name="description" content="First">
+<meta name="description" content="Second">
+<link rel="canonical" href="https://example.com/docs/">
+</head><body></body></html>`,
+			internal.Metadata{
+				Description:       "First",
+				DescriptionLength: 5,
+				Robots:            []string{},
+				XRobotsTag:        []string{},
+				Canonical:         "https://example.com/docs/",
+				Hreflang:          []internal.Hreflang{},
+				Charset:           "iso-8859-1",
+				Warnings: []internal.MetadataWarning{
+					internal.MetadataWarningTitleDuplicate,
+					internal.MetadataWarningDescriptionDuplicate,
+				},
+			},
+		},
+		{
+			"OK: missing",
+			"https://example.com/",
+			nil,
+			`<html><head><title> </title><meta name="description" content=""></head><body></body></html>`,
+			internal.Metadata{
+				Robots:     []string{},
+				XRobotsTag: []string{},
+				Hreflang:   []internal.Hreflang{},
+				Warnings: []internal.MetadataWarning{
+					internal.MetadataWarningTitleMissing,
+					internal.MetadataWarningDescriptionMissing,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			pageURL, _ := url.Parse(tt.pageURL)
+
+			actual := detectMetadata(&Page{
+				Fetch:    FetchResult{URL: pageURL, Header: tt.header},
+				Document: doc,
+				Base:     pageURL,
+			})
+
+			if !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/idna"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const defaultNormalizerScheme = "https"
+
+var defaultNormalizerStrippedParams = []string{
+	"utm_*",
+	"gclid",
+	"dclid",
+	"fbclid",
+	"msclkid",
+	"yclid",
+	"mc_cid",
+	"mc_eid",
+	"_ga",
+	"igshid",
+}
+
+var (
+	schemeRegEx  = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://`)
+	defaultPorts = map[string]string{
+		"http":  "80",
+		"https": "443",
+	}
+	// hostProfile maps and validates hosts like idna.Lookup but accepts the underscores found in the wild
+	hostProfile = idna.New(idna.MapForLookup(), idna.BidiRule(), idna.StrictDomainName(false))
+)
+
+// NormalizerConfig defines how submitted URLs are normalized, zero values use the defaults
+type NormalizerConfig struct {
+	// DefaultScheme is used for URLs submitted without scheme
+	DefaultScheme string
+	// StrippedParams lists the query parameters removed from URLs, a trailing "*" matches any suffix
+	StrippedParams []string
+}
+
+// Normalizer turns the submitted URLs into the canonical form used for fetching and grouping analyses
+type Normalizer struct {
+	defaultScheme  string
+	strippedParams []string
+}
+
+// NewNormalizer instantiates the normalizer
+func NewNormalizer(config NormalizerConfig) *Normalizer {
+	normalizer := &Normalizer{
+		defaultScheme: strings.ToLower(config.DefaultScheme),
+	}
+
+	if normalizer.defaultScheme == "" {
+		normalizer.defaultScheme = defaultNormalizerScheme
+	}
+
+	for _, param := range config.StrippedParams {
+		if param = strings.ToLower(strings.TrimSpace(param)); param != "" {
+			normalizer.strippedParams = append(normalizer.strippedParams, param)
+		}
+	}
+
+	if normalizer.strippedParams == nil {
+		normalizer.strippedParams = defaultNormalizerStrippedParams
+	}
+
+	return normalizer
+}
+
+// Normalize defaults the scheme, lowercases the host and converts it to punycode, strips the default port,
+// the dot segments of the path, the tracking parameters and the fragment.
+func (n *Normalizer) Normalize(rawURL string) (string, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return "", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "URL is required")
+	}
+
+	if strings.HasPrefix(rawURL, "//") {
+		rawURL = n.defaultScheme + ":" + rawURL
+	} else if !schemeRegEx.MatchString(rawURL) {
+		rawURL = n.defaultScheme + "://" + rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid URL")
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	host, err := normalizeHost(u.Hostname())
+	if err != nil {
+		return "", err
+	}
+
+	if port := u.Port(); port != "" && port != defaultPorts[u.Scheme] {
+		host = net.JoinHostPort(host, port)
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+
+	u.Host = host
+
+	escaped := removeDotSegments(u.EscapedPath())
+
+	path, err := url.PathUnescape(escaped)
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid URL path")
+	}
+
+	u.Path, u.RawPath = path, escaped
+
+	if u.Path == "" {
+		u.Path = "/"
+		u.RawPath = ""
+	}
+
+	u.RawQuery = n.stripParams(u.RawQuery)
+	u.ForceQuery = false
+	u.Fragment = ""
+	u.RawFragment = ""
+
+	return u.String(), nil
+}
+
+// stripParams removes the tracking parameters from the query, keeping the others in their original order
+// and encoding
+func (n *Normalizer) stripParams(query string) string {
+	if query == "" {
+		return ""
+	}
+
+	var kept []string
+
+	for _, param := range strings.Split(query, "&") {
+		if param == "" {
+			continue
+		}
+
+		name := strings.SplitN(param, "=", 2)[0]
+		if unescaped, err := url.QueryUnescape(name); err == nil {
+			name = unescaped
+		}
+
+		if !n.stripped(strings.ToLower(name)) {
+			kept = append(kept, param)
+		}
+	}
+
+	return strings.Join(kept, "&")
+}
+
+func (n *Normalizer) stripped(name string) bool {
+	for _, param := range n.strippedParams {
+		if strings.HasSuffix(param, "*") {
+			if strings.HasPrefix(name, strings.TrimSuffix(param, "*")) {
+				return true
+			}
+		} else if name == param {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeHost lowercases the host and converts internationalized domain names to punycode, IP addresses
+// are kept as is
+func normalizeHost(host string) (string, error) {
+	if host == "" {
+		return "", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "URL host is required")
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String(), nil
+	}
+
+	ascii, err := hostProfile.ToASCII(strings.TrimSuffix(host, "."))
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid URL host")
+	}
+
+	return strings.ToLower(ascii), nil
+}
+
+// removeDotSegments removes the "." and ".." segments of the path as described by RFC 3986 section 5.2.4
+func removeDotSegments(path string) string {
+	if !strings.Contains(path, ".") {
+		return path
+	}
+
+	segments := strings.Split(path, "/")
+
+	var out []string
+
+	for i, segment := range segments {
+		last := i == len(segments)-1
+
+		switch segment {
+		case ".":
+			if last {
+				out = append(out, "")
+			}
+		case "..":
+			if len(out) > 1 {
+				out = out[:len(out)-1]
+			}
+
+			if last {
+				out = append(out, "")
+			}
+		default:
+			out = append(out, segment)
+		}
+	}
+
+	return strings.Join(out, "/")
+}
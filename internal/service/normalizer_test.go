@@ -0,0 +1,87 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/service"
+)
+
+func TestNormalizer_Normalize(t *testing.T) {
+	t.Parallel()
+
+	normalizer := service.NewNormalizer(service.NormalizerConfig{})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		withErr  bool
+	}{
+		{"OK: already normalized", "https://example.com/", "https://example.com/", false},
+		{"OK: host case and empty path", "https://Example.COM", "https://example.com/", false},
+		{"OK: missing scheme", "example.com", "https://example.com/", false},
+		{"OK: missing scheme with port", "example.com:8080/a", "https://example.com:8080/a", false},
+		{"OK: scheme relative", "//example.com/a", "https://example.com/a", false},
+		{"OK: scheme case", "HTTP://example.com/", "http://example.com/", false},
+		{"OK: default http port", "http://example.com:80/a", "http://example.com/a", false},
+		{"OK: default https port", "https://example.com:443/a", "https://example.com/a", false},
+		{"OK: other port", "http://example.com:443/a", "http://example.com:443/a", false},
+		{"OK: IDN", "https://Bücher.example/", "https://xn--bcher-kva.example/", false},
+		{"OK: trailing dot", "https://example.com./", "https://example.com/", false},
+		{"OK: underscore", "https://my_host.example.com/", "https://my_host.example.com/", false},
+		{"OK: IPv6", "http://[2001:DB8::1]:80/", "http://[2001:db8::1]/", false},
+		{"OK: dot segments", "https://example.com/a/./b/../c/.", "https://example.com/a/c/", false},
+		{"OK: dot segments above root", "https://example.com/../a", "https://example.com/a", false},
+		{"OK: escaped path", "https://example.com/a%2Fb/../c", "https://example.com/c", false},
+		{"OK: tracking params", "https://example.com/?utm_source=x&id=1&UTM_Medium=y&fbclid=z", "https://example.com/?id=1", false},
+		{"OK: only tracking params", "https://example.com/?utm_source=x", "https://example.com/", false},
+		{"OK: query order kept", "https://example.com/?b=2&a=1", "https://example.com/?b=2&a=1", false},
+		{"OK: fragment", "https://example.com/a#section", "https://example.com/a", false},
+		{"OK: spaces", "  https://example.com/  ", "https://example.com/", false},
+		{"ERR: empty", " ", "", true},
+		{"ERR: host", "https:///a", "", true},
+		{"ERR: port", "https://example.com:port/", "", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := normalizer.Normalize(tt.input)
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %v", tt.withErr, err)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument) {
+				t.Fatalf("expected invalid argument error, got %v", err)
+			}
+
+			if actual != tt.expected {
+				t.Fatalf("expected %q, actual %q", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNormalizer_NormalizeConfig(t *testing.T) {
+	t.Parallel()
+
+	normalizer := service.NewNormalizer(service.NormalizerConfig{
+		DefaultScheme:  "http",
+		StrippedParams: []string{" ref ", "Session*", ""},
+	})
+
+	actual, err := normalizer.Normalize("example.com/?ref=x&sessionid=1&utm_source=y")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if expected := "http://example.com/?utm_source=y"; actual != expected {
+		t.Fatalf("expected %q, actual %q", expected, actual)
+	}
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const (
+	defaultPolitenessInterval     = 100 * time.Millisecond
+	defaultPolitenessBurst        = 10
+	defaultPolitenessRobotsMaxAge = time.Hour
+	defaultPolitenessRobotsErrAge = time.Minute
+
+	robotsTimeout      = 10 * time.Second
+	robotsMaxBodyBytes = 500 << 10
+
+	// maxPolitenessHosts is the number of hosts remembered before expired entries are evicted
+	maxPolitenessHosts = 10000
+)
+
+// PolitenessConfig defines how the analyzed sites are spared, zero values use the defaults
+type PolitenessConfig struct {
+	// UserAgent selects the robots.txt group to honor
+	UserAgent string
+	// Interval is the time a host takes to accept one more request, a longer Crawl-delay replaces it
+	Interval time.Duration
+	// Burst is the number of requests a host accepts at once after being idle
+	Burst int
+	// RobotsMaxAge is how long robots.txt is cached
+	RobotsMaxAge time.Duration
+	// ExemptHosts are neither checked against robots.txt nor rate limited, meant for internal sites.
+	// A leading "*." matches any subdomain.
+	ExemptHosts []string
+}
+
+// Politeness honors robots.txt and limits the rate of requests sent to each host, it's shared by everything
+// requesting the analyzed sites. A nil Politeness allows everything.
+type Politeness struct {
+	client      *http.Client
+	userAgent   string
+	interval    time.Duration
+	burst       int
+	maxAge      time.Duration
+	exemptHosts []string
+
+	mu      sync.Mutex
+	robots  map[string]*robotsEntry
+	buckets map[string]*bucket
+}
+
+// robotsEntry is robots.txt of one origin, rules and expires are set before ready is closed
+type robotsEntry struct {
+	ready   chan struct{}
+	rules   robotsRules
+	expires time.Time
+}
+
+// bucket is the token bucket of one host
+type bucket struct {
+	tokens   float64
+	last     time.Time
+	interval time.Duration
+	burst    int
+}
+
+// NewPoliteness instantiates a Politeness fetching robots.txt with client
+func NewPoliteness(client *http.Client, config PolitenessConfig) *Politeness {
+	p := &Politeness{
+		client:    client,
+		userAgent: strings.TrimSpace(config.UserAgent),
+		interval:  config.Interval,
+		burst:     config.Burst,
+		maxAge:    config.RobotsMaxAge,
+		robots:    make(map[string]*robotsEntry),
+		buckets:   make(map[string]*bucket),
+	}
+
+	if p.userAgent == "" {
+		p.userAgent = defaultFetcherUserAgent
+	}
+
+	if p.interval <= 0 {
+		p.interval = defaultPolitenessInterval
+	}
+
+	if p.burst <= 0 {
+		p.burst = defaultPolitenessBurst
+	}
+
+	if p.maxAge <= 0 {
+		p.maxAge = defaultPolitenessRobotsMaxAge
+	}
+
+	for _, host := range config.ExemptHosts {
+		if host = strings.ToLower(strings.TrimSpace(host)); host != "" {
+			p.exemptHosts = append(p.exemptHosts, host)
+		}
+	}
+
+	return p
+}
+
+// Allowed returns an error when robots.txt of the target's origin disallows requesting it
+func (p *Politeness) Allowed(ctx context.Context, target *url.URL) error {
+	if p == nil || p.exempt(target) {
+		return nil
+	}
+
+	rules := p.robotsRules(ctx, target)
+
+	path := target.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	if target.RawQuery != "" {
+		path += "?" + target.RawQuery
+	}
+
+	if !rules.allowed(path) {
+		return internal.NewErrorf(internal.ErrorCodeDisallowedByRobots, "%s is disallowed by robots.txt", target)
+	}
+
+	return nil
+}
+
+// Wait blocks until the target's host accepts one more request or ctx is done
+func (p *Politeness) Wait(ctx context.Context, target *url.URL) error {
+	if p == nil || p.exempt(target) {
+		return nil
+	}
+
+	interval, burst := p.interval, p.burst
+
+	if delay := p.robotsRules(ctx, target).crawlDelay; delay > interval {
+		interval, burst = delay, 1
+	}
+
+	wait := p.reserve(strings.ToLower(target.Hostname()), interval, burst)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes one token from the host's bucket and returns how long to wait before using it
+func (p *Politeness) reserve(host string, interval time.Duration, burst int) time.Duration {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	b, ok := p.buckets[host]
+	if !ok {
+		if len(p.buckets) >= maxPolitenessHosts {
+			p.evictBuckets(now)
+		}
+
+		b = &bucket{tokens: float64(burst), last: now}
+		p.buckets[host] = b
+	}
+
+	b.interval, b.burst = interval, burst
+
+	b.tokens += float64(now.Sub(b.last)) / float64(b.interval)
+	if b.tokens > float64(b.burst) {
+		b.tokens = float64(b.burst)
+	}
+
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens * float64(b.interval))
+}
+
+// evictBuckets drops the buckets refilled completely, those hosts are treated as never seen
+func (p *Politeness) evictBuckets(now time.Time) {
+	for host, b := range p.buckets {
+		if b.tokens+float64(now.Sub(b.last))/float64(b.interval) >= float64(b.burst) {
+			delete(p.buckets, host)
+		}
+	}
+}
+
+// robotsRules returns the cached rules of the target's origin, fetching robots.txt once for all the
+// concurrent callers when missing or expired
+func (p *Politeness) robotsRules(ctx context.Context, target *url.URL) robotsRules {
+	origin := strings.ToLower(target.Scheme + "://" + target.Host)
+
+	p.mu.Lock()
+
+	entry, ok := p.robots[origin]
+	if ok {
+		select {
+		case <-entry.ready:
+			ok = time.Now().Before(entry.expires)
+		default:
+		}
+	}
+
+	if !ok {
+		if len(p.robots) >= maxPolitenessHosts {
+			p.evictRobots(time.Now())
+		}
+
+		entry = &robotsEntry{ready: make(chan struct{})}
+		p.robots[origin] = entry
+
+		// the fetch outlives the caller because other callers may be waiting for it
+		go func() {
+			ctx, cancel := context.WithTimeout(trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx)), robotsTimeout)
+			defer cancel()
+
+			entry.rules, entry.expires = p.fetchRobots(ctx, origin)
+
+			close(entry.ready)
+		}()
+	}
+
+	p.mu.Unlock()
+
+	select {
+	case <-entry.ready:
+		return entry.rules
+	case <-ctx.Done():
+		return robotsRules{}
+	}
+}
+
+func (p *Politeness) evictRobots(now time.Time) {
+	for origin, entry := range p.robots {
+		select {
+		case <-entry.ready:
+			if now.After(entry.expires) {
+				delete(p.robots, origin)
+			}
+		default:
+		}
+	}
+}
+
+// fetchRobots requests robots.txt of origin. A missing robots.txt allows everything, so does one that
+// can't be retrieved, the latter is retried sooner so a flaky server doesn't block the analyses.
+func (p *Politeness) fetchRobots(ctx context.Context, origin string) (robotsRules, time.Time) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "Politeness.fetchRobots")
+	defer span.End()
+
+	failed := time.Now().Add(defaultPolitenessRobotsErrAge)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err != nil {
+		return robotsRules{}, failed
+	}
+
+	req.Header.Set("User-Agent", p.userAgent)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return robotsRules{}, failed
+	}
+
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return robotsRules{}, failed
+	case resp.StatusCode >= http.StatusBadRequest:
+		return robotsRules{}, time.Now().Add(p.maxAge)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, robotsMaxBodyBytes))
+	if err != nil {
+		return robotsRules{}, failed
+	}
+
+	return parseRobots(body, p.userAgent), time.Now().Add(p.maxAge)
+}
+
+func (p *Politeness) exempt(target *url.URL) bool {
+	host := strings.ToLower(target.Hostname())
+
+	for _, exempt := range p.exemptHosts {
+		if host == exempt || (strings.HasPrefix(exempt, "*.") && strings.HasSuffix(host, exempt[1:])) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+	"github.com/Oguzyildirim/url-info/internal/service"
+)
+
+func TestPoliteness_Allowed(t *testing.T) {
+	t.Parallel()
+
+	var robotsRequests int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			atomic.AddInt32(&robotsRequests, 1)
+
+			if r.Header.Get("User-Agent") != "url-info/1.0" {
+				t.Errorf("expected configured user agent, got %q", r.Header.Get("User-Agent"))
+			}
+
+			_, _ = w.Write([]byte("User-agent: url-info\nDisallow: /private\n"))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	politeness := service.NewPoliteness(srv.Client(), service.PolitenessConfig{
+		UserAgent: "url-info/1.0",
+	})
+
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{"OK", "/public", true},
+		{"OK: query", "/?private", true},
+		{"ERR: disallowed", "/private/page", false},
+	}
+
+	for _, tt := range tests {
+		target, _ := url.Parse(srv.URL + tt.path)
+
+		err := politeness.Allowed(context.Background(), target)
+		if (err == nil) != tt.expected {
+			t.Fatalf("%s: expected allowed %t, got %v", tt.name, tt.expected, err)
+		}
+
+		var ierr *internal.Error
+		if err != nil && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeDisallowedByRobots) {
+			t.Fatalf("%s: expected disallowed by robots error, got %v", tt.name, err)
+		}
+	}
+
+	if n := atomic.LoadInt32(&robotsRequests); n != 1 {
+		t.Fatalf("expected robots.txt to be requested once, got %d", n)
+	}
+
+	exempt := service.NewPoliteness(srv.Client(), service.PolitenessConfig{
+		UserAgent:   "url-info/1.0",
+		ExemptHosts: []string{"127.0.0.1"},
+	})
+
+	target, _ := url.Parse(srv.URL + "/private")
+
+	if err := exempt.Allowed(context.Background(), target); err != nil {
+		t.Fatalf("expected exempt host to be allowed, got %v", err)
+	}
+}
+
+func TestPoliteness_Allowed_BlankUserAgent(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			if strings.TrimSpace(r.Header.Get("User-Agent")) == "" {
+				t.Errorf("expected the default user agent, got %q", r.Header.Get("User-Agent"))
+			}
+
+			_, _ = w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	politeness := service.NewPoliteness(srv.Client(), service.PolitenessConfig{
+		UserAgent: "   ",
+	})
+
+	target, _ := url.Parse(srv.URL + "/private")
+
+	var ierr *internal.Error
+	if err := politeness.Allowed(context.Background(), target); !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeDisallowedByRobots {
+		t.Fatalf("expected disallowed by robots error, got %v", err)
+	}
+}
+
+func TestPoliteness_Wait(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			_, _ = w.Write([]byte("User-agent: *\nCrawl-delay: 0.1\n"))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	target, _ := url.Parse(srv.URL + "/page")
+
+	tests := []struct {
+		name     string
+		config   service.PolitenessConfig
+		min, max time.Duration
+	}{
+		{
+			"OK: crawl delay",
+			service.PolitenessConfig{Interval: time.Millisecond, Burst: 10},
+			200 * time.Millisecond,
+			time.Second,
+		},
+		{
+			"OK: burst",
+			service.PolitenessConfig{Interval: time.Hour, Burst: 3},
+			0,
+			time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			politeness := service.NewPoliteness(srv.Client(), tt.config)
+
+			start := time.Now()
+
+			// the crawl delay lets one request through then one every 100ms
+			for i := 0; i < 3; i++ {
+				if err := politeness.Wait(context.Background(), target); err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+			}
+
+			if elapsed := time.Since(start); elapsed < tt.min || elapsed > tt.max {
+				t.Fatalf("expected to wait between %s and %s, waited %s", tt.min, tt.max, elapsed)
+			}
+		})
+	}
+
+	politeness := service.NewPoliteness(srv.Client(), service.PolitenessConfig{Interval: time.Hour, Burst: 1})
+
+	_ = politeness.Wait(context.Background(), target)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := politeness.Wait(ctx, target); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCrawlDelay caps the Crawl-delay of robots.txt so one site can't stall the analyses for minutes
+const maxCrawlDelay = 30 * time.Second
+
+// robotsRules are the rules of robots.txt applying to one user agent
+type robotsRules struct {
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// robotsGroup is a set of rules preceded by one or more User-agent lines
+type robotsGroup struct {
+	agents     []string
+	rules      []robotsRule
+	crawlDelay time.Duration
+}
+
+// parseRobots returns the rules of the groups matching the product token of userAgent, the groups of *
+// are used when none matches. Unknown lines and lines without colon are ignored.
+func parseRobots(body []byte, userAgent string) robotsRules {
+	var (
+		groups []*robotsGroup
+		group  *robotsGroup
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 4096), len(body)+1)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		key, value := strings.ToLower(strings.TrimSpace(parts[0])), strings.TrimSpace(parts[1])
+
+		switch key {
+		case "user-agent":
+			// consecutive User-agent lines share the rules that follow them
+			if group == nil || len(group.rules) > 0 || group.crawlDelay > 0 {
+				group = &robotsGroup{}
+				groups = append(groups, group)
+			}
+
+			group.agents = append(group.agents, strings.ToLower(value))
+		case "allow", "disallow":
+			if group == nil || value == "" {
+				continue
+			}
+
+			group.rules = append(group.rules, robotsRule{
+				allow:   key == "allow",
+				pattern: value,
+			})
+		case "crawl-delay":
+			if group == nil {
+				continue
+			}
+
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil || seconds <= 0 {
+				continue
+			}
+
+			group.crawlDelay = time.Duration(seconds * float64(time.Second))
+			if group.crawlDelay > maxCrawlDelay {
+				group.crawlDelay = maxCrawlDelay
+			}
+		}
+	}
+
+	res, ok := matchRobotsGroups(groups, robotsProductToken(userAgent))
+	if !ok {
+		res, _ = matchRobotsGroups(groups, "*")
+	}
+
+	return res
+}
+
+func matchRobotsGroups(groups []*robotsGroup, agent string) (res robotsRules, ok bool) {
+	for _, group := range groups {
+		if !hasToken(group.agents, agent) {
+			continue
+		}
+
+		ok = true
+
+		res.rules = append(res.rules, group.rules...)
+
+		if group.crawlDelay > res.crawlDelay {
+			res.crawlDelay = group.crawlDelay
+		}
+	}
+
+	return res, ok
+}
+
+// robotsProductToken returns the name robots.txt refers to, "url-info" for "url-info/1.0 (+https://...)", a
+// blank userAgent only matches the * groups
+func robotsProductToken(userAgent string) string {
+	fields := strings.Fields(userAgent)
+	if len(fields) == 0 {
+		return "*"
+	}
+
+	token := fields[0]
+	if i := strings.Index(token, "/"); i >= 0 {
+		token = token[:i]
+	}
+
+	return strings.ToLower(token)
+}
+
+// allowed indicates whether the path, query included, may be requested. The longest matching pattern wins,
+// Allow wins over Disallow when both are as long.
+func (r robotsRules) allowed(path string) bool {
+	if path == "/robots.txt" {
+		return true
+	}
+
+	allow, length := true, -1
+
+	for _, rule := range r.rules {
+		if !matchRobotsPattern(rule.pattern, path) {
+			continue
+		}
+
+		if len(rule.pattern) > length || (len(rule.pattern) == length && rule.allow) {
+			allow, length = rule.allow, len(rule.pattern)
+		}
+	}
+
+	return allow
+}
+
+// matchRobotsPattern matches the path prefix against pattern, * matches any sequence of characters and a
+// trailing $ matches the end of the path
+func matchRobotsPattern(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	pattern = strings.TrimSuffix(pattern, "$")
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+
+	path = path[len(parts[0]):]
+
+	for i, part := range parts[1:] {
+		if anchored && i == len(parts)-2 {
+			return strings.HasSuffix(path, part)
+		}
+
+		j := strings.Index(path, part)
+		if j < 0 {
+			return false
+		}
+
+		path = path[j+len(part):]
+	}
+
+	return !anchored || path == ""
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRobots(t *testing.T) {
+	t.Parallel()
+
+	const robots = `# robots.txt
+User-agent: *
+Disallow: /private/
+Allow: /private/public$
+Crawl-delay: 120
+
+User-agent: url-info
+User-agent: other
+Disallow: /admin   # comment
+Disallow: /*.pdf$
+Allow: /admin/help
+Disallow:
+Crawl-delay: 0.5
+
+User-agent: url-info
+Disallow: /tmp
+`
+
+	tests := []struct {
+		name      string
+		userAgent string
+		path      string
+		expected  bool
+		delay     time.Duration
+	}{
+		{"OK: root", "url-info/1.0 (+https://example.com)", "/", true, 500 * time.Millisecond},
+		{"OK: longest allow", "url-info/1.0", "/admin/help/page", true, 500 * time.Millisecond},
+		{"OK: other group", "url-info/1.0", "/private/", true, 500 * time.Millisecond},
+		{"OK: anchored", "url-info/1.0", "/docs/guide.pdf?page=2", true, 500 * time.Millisecond},
+		{"OK: robots.txt", "url-info/1.0", "/robots.txt", true, 500 * time.Millisecond},
+		{"OK: star allow", "Mozilla/5.0", "/private/public", true, maxCrawlDelay},
+		{"ERR: disallow", "url-info/1.0", "/admin/users", false, 500 * time.Millisecond},
+		{"ERR: wildcard", "url-info/1.0", "/docs/guide.pdf", false, 500 * time.Millisecond},
+		{"ERR: merged group", "URL-Info", "/tmp/file", false, 500 * time.Millisecond},
+		{"ERR: star", "Mozilla/5.0", "/private/public/more", false, maxCrawlDelay},
+		{"ERR: blank user agent", "   ", "/private/public/more", false, maxCrawlDelay},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules := parseRobots([]byte(robots), tt.userAgent)
+
+			if actual := rules.allowed(tt.path); actual != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+
+			if rules.crawlDelay != tt.delay {
+				t.Fatalf("expected delay %s, got %s", tt.delay, rules.crawlDelay)
+			}
+		})
+	}
+}
+
+func TestMatchRobotsPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{"OK: prefix", "/fish", "/fish.html", true},
+		{"OK: star", "/*/fish", "/salmon/fish/", true},
+		{"OK: stars", "/a*b*c", "/aXbYbc", true},
+		{"OK: anchored", "/*.php$", "/index.php", true},
+		{"OK: anchored exact", "/fish$", "/fish", true},
+		{"ERR: prefix", "/fish", "/Fish.html", false},
+		{"ERR: star", "/*/fish", "/fish", false},
+		{"ERR: anchored", "/*.php$", "/index.php?x=1", false},
+		{"ERR: anchored exact", "/fish$", "/fishes", false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := matchRobotsPattern(tt.pattern, tt.path); actual != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// minScheduleInterval is the shortest interval accepted between two runs of a watch
+const minScheduleInterval = time.Minute
+
+// scheduleHorizon bounds the search for the next run of cron expressions matching rarely or never
+const scheduleHorizon = 5 * 366 * 24 * time.Hour
+
+var scheduleDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// schedule computes when a watch runs next
+type schedule interface {
+	next(after time.Time) time.Time
+}
+
+// parseSchedule accepts intervals like "1h" or "@every 1h", the @hourly, @daily, @weekly, @monthly and
+// @yearly descriptors and 5 fields cron expressions made of numbers, ranges, lists and steps
+func parseSchedule(spec string) (schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if descriptor, ok := scheduleDescriptors[strings.ToLower(spec)]; ok {
+		spec = descriptor
+	}
+
+	if strings.HasPrefix(spec, "@every ") || len(strings.Fields(spec)) == 1 {
+		d, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid schedule interval")
+		}
+
+		if d < minScheduleInterval {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "schedule interval must be at least %s", minScheduleInterval)
+		}
+
+		return intervalSchedule(d), nil
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "cron schedule must have 5 fields")
+	}
+
+	var (
+		s   cronSchedule
+		err error
+	)
+
+	if s.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+
+	if s.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+
+	if s.dom, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+
+	if s.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+
+	if s.dow, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, err
+	}
+
+	// 7 is an alias of Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	s.domAny = strings.HasPrefix(fields[2], "*")
+	s.dowAny = strings.HasPrefix(fields[4], "*")
+
+	if s.next(time.Now()).IsZero() {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "cron schedule never runs")
+	}
+
+	return s, nil
+}
+
+type intervalSchedule time.Duration
+
+func (s intervalSchedule) next(after time.Time) time.Time {
+	return after.Add(time.Duration(s))
+}
+
+// cronSchedule holds the allowed values of each field as bit sets
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+// next returns the first minute after the given time matching the schedule, evaluated in UTC, the zero
+// time is returned when nothing matches within the horizon
+func (s cronSchedule) next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	end := t.Add(scheduleHorizon)
+
+	for t.Before(end) {
+		switch {
+		case s.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case s.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case s.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+// matchDay follows cron: when both the day of month and the day of week are restricted either one matches
+func (s cronSchedule) matchDay(t time.Time) bool {
+	dom := s.dom&(1<<uint(t.Day())) != 0
+	dow := s.dow&(1<<uint(t.Weekday())) != 0
+
+	if s.domAny || s.dowAny {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// parseCronField returns the bit set of the values allowed by a comma separated list of "*", "n", "n-m",
+// each optionally followed by "/step"
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		expr, step := part, 1
+
+		if i := strings.Index(part, "/"); i >= 0 {
+			val, err := strconv.Atoi(part[i+1:])
+			if err != nil || val <= 0 {
+				return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cron step %q", part)
+			}
+
+			expr, step = part[:i], val
+		}
+
+		start, end := min, max
+
+		switch {
+		case expr == "*":
+		case strings.Contains(expr, "-"):
+			bounds := strings.SplitN(expr, "-", 2)
+
+			var err error
+			if start, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cron range %q", part)
+			}
+
+			if end, err = strconv.Atoi(bounds[1]); err != nil {
+				return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cron range %q", part)
+			}
+		default:
+			val, err := strconv.Atoi(expr)
+			if err != nil {
+				return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cron value %q", part)
+			}
+
+			start, end = val, val
+			if step > 1 {
+				end = max
+			}
+		}
+
+		if start < min || end > max || start > end {
+			return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "cron value %q out of range %d-%d", part, min, max)
+		}
+
+		for val := start; val <= end; val += step {
+			bits |= 1 << uint(val)
+		}
+	}
+
+	return bits, nil
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestParseSchedule(t *testing.T) {
+	t.Parallel()
+
+	// Friday
+	after := time.Date(2021, 7, 2, 10, 7, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec     string
+		expected time.Time
+		withErr  bool
+	}{
+		{"1h", time.Date(2021, 7, 2, 11, 7, 30, 0, time.UTC), false},
+		{"@every 30m", time.Date(2021, 7, 2, 10, 37, 30, 0, time.UTC), false},
+		{"@hourly", time.Date(2021, 7, 2, 11, 0, 0, 0, time.UTC), false},
+		{"@daily", time.Date(2021, 7, 3, 0, 0, 0, 0, time.UTC), false},
+		{"@weekly", time.Date(2021, 7, 4, 0, 0, 0, 0, time.UTC), false},
+		{"@monthly", time.Date(2021, 8, 1, 0, 0, 0, 0, time.UTC), false},
+		{"*/15 * * * *", time.Date(2021, 7, 2, 10, 15, 0, 0, time.UTC), false},
+		{"0 9-17/4 * * *", time.Date(2021, 7, 2, 13, 0, 0, 0, time.UTC), false},
+		{"30 6 * * 1,3", time.Date(2021, 7, 5, 6, 30, 0, 0, time.UTC), false},
+		{"0 0 * * 7", time.Date(2021, 7, 4, 0, 0, 0, 0, time.UTC), false},
+		{"0 0 15 * 1", time.Date(2021, 7, 5, 0, 0, 0, 0, time.UTC), false},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"30s", time.Time{}, true},
+		{"soon", time.Time{}, true},
+		{"* * * *", time.Time{}, true},
+		{"60 * * * *", time.Time{}, true},
+		{"* * * 0 *", time.Time{}, true},
+		{"5-1 * * * *", time.Time{}, true},
+		{"*/0 * * * *", time.Time{}, true},
+		{"0 0 30 2 *", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.spec, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := parseSchedule(tt.spec)
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %v", tt.withErr, err)
+			}
+
+			if tt.withErr {
+				var ierr *internal.Error
+				if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+					t.Fatalf("expected invalid argument error, got %v", err)
+				}
+
+				return
+			}
+
+			if actual := s.next(after); !actual.Equal(tt.expected) {
+				t.Fatalf("expected %s, actual %s", tt.expected, actual)
+			}
+		})
+	}
+}
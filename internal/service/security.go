@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const (
+	// securityHSTSMinMaxAge is the shortest HSTS max-age not flagged, 180 days
+	securityHSTSMinMaxAge = 180 * 24 * 60 * 60
+	// securityTLSExpiringDays is the number of days before the certificate expiry it's flagged
+	securityTLSExpiringDays = 30
+)
+
+// securityPenalties are the points a warning takes from the security score
+var securityPenalties = map[internal.SecurityWarning]int{
+	internal.SecurityWarningTLSMissing:                 40,
+	internal.SecurityWarningTLSExpiring:                5,
+	internal.SecurityWarningHSTSMissing:                15,
+	internal.SecurityWarningHSTSShortMaxAge:            5,
+	internal.SecurityWarningCSPMissing:                 15,
+	internal.SecurityWarningCSPUnsafeInline:            10,
+	internal.SecurityWarningCSPUnsafeEval:              5,
+	internal.SecurityWarningXFrameOptionsMissing:       10,
+	internal.SecurityWarningXContentTypeOptionsMissing: 5,
+	internal.SecurityWarningReferrerPolicyMissing:      5,
+	internal.SecurityWarningPermissionsPolicyMissing:   5,
+	internal.SecurityWarningCookieInsecure:             10,
+	internal.SecurityWarningCookieNoHTTPOnly:           5,
+	internal.SecurityWarningCookieNoSameSite:           5,
+}
+
+// securityAnalyzer reports the security headers, cookies and TLS connection of the final response as the
+// security section
+type securityAnalyzer struct{}
+
+func (securityAnalyzer) Name() string { return "security" }
+
+func (securityAnalyzer) Concurrent() bool { return true }
+
+func (a securityAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	return NewSection(a.Name(), detectSecurity(page.Fetch, time.Now()))
+}
+
+// detectSecurity analyzes the response, now is used for computing the days until the certificate expires
+func detectSecurity(res FetchResult, now time.Time) internal.Security {
+	header := res.Header
+
+	security := internal.Security{
+		HSTS:                parseHSTS(header.Get("Strict-Transport-Security")),
+		CSP:                 parseCSP(header.Values("Content-Security-Policy")),
+		XFrameOptions:       strings.TrimSpace(header.Get("X-Frame-Options")),
+		XContentTypeOptions: strings.TrimSpace(header.Get("X-Content-Type-Options")),
+		ReferrerPolicy:      strings.TrimSpace(header.Get("Referrer-Policy")),
+		PermissionsPolicy:   strings.TrimSpace(header.Get("Permissions-Policy")),
+		Cookies:             []internal.SecurityCookie{},
+		TLS:                 newTLS(res.TLS, now),
+		Warnings:            []internal.SecurityWarning{},
+	}
+
+	warn := func(warning internal.SecurityWarning) {
+		security.Warnings = append(security.Warnings, warning)
+	}
+
+	if tlsInfo := security.TLS; tlsInfo == nil {
+		warn(internal.SecurityWarningTLSMissing)
+	} else {
+		// the connection was verified when fetching, expired certificates and versions older than TLS 1.2
+		// fail the fetch
+		if !tlsInfo.NotAfter.IsZero() && tlsInfo.ExpiresInDays < securityTLSExpiringDays {
+			warn(internal.SecurityWarningTLSExpiring)
+		}
+
+		// browsers ignore HSTS received over HTTP
+		switch {
+		case !security.HSTS.Present:
+			warn(internal.SecurityWarningHSTSMissing)
+		case security.HSTS.MaxAge < securityHSTSMinMaxAge:
+			warn(internal.SecurityWarningHSTSShortMaxAge)
+		}
+	}
+
+	if !security.CSP.Present {
+		warn(internal.SecurityWarningCSPMissing)
+	}
+
+	if security.CSP.UnsafeInline {
+		warn(internal.SecurityWarningCSPUnsafeInline)
+	}
+
+	if security.CSP.UnsafeEval {
+		warn(internal.SecurityWarningCSPUnsafeEval)
+	}
+
+	// frame-ancestors supersedes X-Frame-Options
+	if _, ok := security.CSP.Directives["frame-ancestors"]; !ok && security.XFrameOptions == "" {
+		warn(internal.SecurityWarningXFrameOptionsMissing)
+	}
+
+	if !strings.EqualFold(security.XContentTypeOptions, "nosniff") {
+		warn(internal.SecurityWarningXContentTypeOptionsMissing)
+	}
+
+	if security.ReferrerPolicy == "" {
+		warn(internal.SecurityWarningReferrerPolicyMissing)
+	}
+
+	if security.PermissionsPolicy == "" {
+		warn(internal.SecurityWarningPermissionsPolicyMissing)
+	}
+
+	var insecure, noHTTPOnly, noSameSite bool
+
+	for _, cookie := range (&http.Response{Header: header}).Cookies() {
+		c := internal.SecurityCookie{
+			Name:     cookie.Name,
+			Secure:   cookie.Secure,
+			HTTPOnly: cookie.HttpOnly,
+			SameSite: sameSiteName(cookie.SameSite),
+		}
+
+		insecure = insecure || !c.Secure
+		noHTTPOnly = noHTTPOnly || !c.HTTPOnly
+		noSameSite = noSameSite || c.SameSite == ""
+
+		security.Cookies = append(security.Cookies, c)
+	}
+
+	if insecure {
+		warn(internal.SecurityWarningCookieInsecure)
+	}
+
+	if noHTTPOnly {
+		warn(internal.SecurityWarningCookieNoHTTPOnly)
+	}
+
+	if noSameSite {
+		warn(internal.SecurityWarningCookieNoSameSite)
+	}
+
+	security.Score = 100
+
+	for _, warning := range security.Warnings {
+		security.Score -= securityPenalties[warning]
+	}
+
+	if security.Score < 0 {
+		security.Score = 0
+	}
+
+	security.Grade = securityGrade(security.Score)
+
+	return security
+}
+
+func securityGrade(score int) internal.SecurityGrade {
+	switch {
+	case score >= 90:
+		return internal.SecurityGradeA
+	case score >= 80:
+		return internal.SecurityGradeB
+	case score >= 70:
+		return internal.SecurityGradeC
+	case score >= 60:
+		return internal.SecurityGradeD
+	}
+
+	return internal.SecurityGradeF
+}
+
+// parseHSTS parses a Strict-Transport-Security header, an invalid max-age is kept as 0
+func parseHSTS(value string) internal.HSTS {
+	var hsts internal.HSTS
+
+	if strings.TrimSpace(value) == "" {
+		return hsts
+	}
+
+	hsts.Present = true
+
+	for _, directive := range strings.Split(value, ";") {
+		name, arg := directive, ""
+		if i := strings.Index(directive, "="); i >= 0 {
+			name, arg = directive[:i], directive[i+1:]
+		}
+
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "max-age":
+			hsts.MaxAge, _ = strconv.ParseInt(strings.Trim(strings.TrimSpace(arg), `"`), 10, 64)
+		case "includesubdomains":
+			hsts.IncludeSubDomains = true
+		case "preload":
+			hsts.Preload = true
+		}
+	}
+
+	return hsts
+}
+
+// parseCSP parses the Content-Security-Policy headers, only the first occurrence of a directive is kept as
+// browsers do
+func parseCSP(values []string) internal.CSP {
+	csp := internal.CSP{
+		Directives: make(map[string][]string),
+	}
+
+	for _, value := range values {
+		// a header can hold several policies separated by commas
+		for _, policy := range strings.Split(value, ",") {
+			for _, directive := range strings.Split(policy, ";") {
+				fields := strings.Fields(directive)
+				if len(fields) == 0 {
+					continue
+				}
+
+				csp.Present = true
+
+				name := strings.ToLower(fields[0])
+				if _, ok := csp.Directives[name]; ok {
+					continue
+				}
+
+				csp.Directives[name] = append([]string{}, fields[1:]...)
+
+				var unsafeInline, allowlisted bool
+
+				for _, source := range fields[1:] {
+					source = strings.ToLower(source)
+
+					switch {
+					case source == "'unsafe-inline'":
+						unsafeInline = true
+					case source == "'unsafe-eval'":
+						csp.UnsafeEval = true
+					case strings.HasPrefix(source, "'nonce-"), strings.HasPrefix(source, "'sha256-"),
+						strings.HasPrefix(source, "'sha384-"), strings.HasPrefix(source, "'sha512-"):
+						allowlisted = true
+					}
+				}
+
+				// browsers ignore 'unsafe-inline' when the directive allows inline code by nonce or hash
+				if unsafeInline && !allowlisted {
+					csp.UnsafeInline = true
+				}
+			}
+		}
+	}
+
+	return csp
+}
+
+func sameSiteName(mode http.SameSite) string {
+	switch mode {
+	case http.SameSiteLaxMode:
+		return "Lax"
+	case http.SameSiteStrictMode:
+		return "Strict"
+	case http.SameSiteNoneMode:
+		return "None"
+	}
+
+	return ""
+}
+
+// newTLS describes the connection, nil when there is none
+func newTLS(state *tls.ConnectionState, now time.Time) *internal.TLS {
+	if state == nil {
+		return nil
+	}
+
+	info := internal.TLS{
+		Version:     tlsVersionName(state.Version),
+		CipherSuite: tls.CipherSuiteName(state.CipherSuite),
+	}
+
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+
+		info.Subject = cert.Subject.String()
+		info.Issuer = cert.Issuer.String()
+		info.NotAfter = cert.NotAfter.UTC()
+		info.ExpiresInDays = int(math.Floor(cert.NotAfter.Sub(now).Hours() / 24))
+	}
+
+	return &info
+}
+
+func tlsVersionName(version uint16) string {
+	switch version {
+	case tls.VersionTLS10:
+		return "TLS 1.0"
+	case tls.VersionTLS11:
+		return "TLS 1.1"
+	case tls.VersionTLS12:
+		return "TLS 1.2"
+	case tls.VersionTLS13:
+		return "TLS 1.3"
+	}
+
+	return fmt.Sprintf("0x%04X", version)
+}
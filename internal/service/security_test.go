@@ -0,0 +1,194 @@
+package service
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDetectSecurity(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	cert := func(notAfter time.Time) *tls.ConnectionState {
+		return &tls.ConnectionState{
+			Version:     tls.VersionTLS13,
+			CipherSuite: tls.TLS_AES_128_GCM_SHA256,
+			PeerCertificates: []*x509.Certificate{
+				{
+					Subject:  pkix.Name{CommonName: "example.com"},
+					Issuer:   pkix.Name{CommonName: "Example CA", Organization: []string{"Example"}},
+					NotAfter: notAfter,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		input    FetchResult
+		expected internal.Security
+	}{
+		{
+			"OK: A",
+			FetchResult{
+				Header: http.Header{
+					"Strict-Transport-Security": []string{`max-age="63072000"; includeSubDomains; preload`},
+					"Content-Security-Policy":   []string{"default-src 'self'; frame-ancestors 'none'; default-src *"},
+					"X-Content-Type-Options":    []string{"NoSniff"},
+					"Referrer-Policy":           []string{"strict-origin-when-cross-origin"},
+					"Permissions-Policy":        []string{"geolocation=()"},
+					"Set-Cookie":                []string{"session=abc; Path=/; Secure; HttpOnly; SameSite=Lax"},
+				},
+				TLS: cert(now.Add(90 * 24 * time.Hour)),
+			},
+			internal.Security{
+				Grade: internal.SecurityGradeA,
+				Score: 100,
+				HSTS:  internal.HSTS{Present: true, MaxAge: 63072000, IncludeSubDomains: true, Preload: true},
+				CSP: internal.CSP{
+					Present: true,
+					Directives: map[string][]string{
+						"default-src":     {"'self'"},
+						"frame-ancestors": {"'none'"},
+					},
+				},
+				XContentTypeOptions: "NoSniff",
+				ReferrerPolicy:      "strict-origin-when-cross-origin",
+				PermissionsPolicy:   "geolocation=()",
+				Cookies:             []internal.SecurityCookie{{Name: "session", Secure: true, HTTPOnly: true, SameSite: "Lax"}},
+				TLS: &internal.TLS{
+					Version:       "TLS 1.3",
+					CipherSuite:   "TLS_AES_128_GCM_SHA256",
+					Subject:       "CN=example.com",
+					Issuer:        "CN=Example CA,O=Example",
+					NotAfter:      now.Add(90 * 24 * time.Hour),
+					ExpiresInDays: 90,
+				},
+				Warnings: []internal.SecurityWarning{},
+			},
+		},
+		{
+			"OK: expiring certificate and unsafe policy",
+			FetchResult{
+				Header: http.Header{
+					"Strict-Transport-Security": []string{"max-age=86400"},
+					"Content-Security-Policy":   []string{"script-src 'self' 'unsafe-inline' 'UNSAFE-EVAL'"},
+					"X-Frame-Options":           []string{"DENY"},
+					"Set-Cookie":                []string{"a=1; Secure", "b=2; HttpOnly; SameSite=Strict"},
+				},
+				TLS: cert(now.Add(36 * time.Hour)),
+			},
+			internal.Security{
+				Grade: internal.SecurityGradeF,
+				Score: 40,
+				HSTS:  internal.HSTS{Present: true, MaxAge: 86400},
+				CSP: internal.CSP{
+					Present:      true,
+					Directives:   map[string][]string{"script-src": {"'self'", "'unsafe-inline'", "'UNSAFE-EVAL'"}},
+					UnsafeInline: true,
+					UnsafeEval:   true,
+				},
+				XFrameOptions: "DENY",
+				Cookies: []internal.SecurityCookie{
+					{Name: "a", Secure: true},
+					{Name: "b", HTTPOnly: true, SameSite: "Strict"},
+				},
+				TLS: &internal.TLS{
+					Version:       "TLS 1.3",
+					CipherSuite:   "TLS_AES_128_GCM_SHA256",
+					Subject:       "CN=example.com",
+					Issuer:        "CN=Example CA,O=Example",
+					NotAfter:      now.Add(36 * time.Hour),
+					ExpiresInDays: 1,
+				},
+				Warnings: []internal.SecurityWarning{
+					internal.SecurityWarningTLSExpiring,
+					internal.SecurityWarningHSTSShortMaxAge,
+					internal.SecurityWarningCSPUnsafeInline,
+					internal.SecurityWarningCSPUnsafeEval,
+					internal.SecurityWarningXContentTypeOptionsMissing,
+					internal.SecurityWarningReferrerPolicyMissing,
+					internal.SecurityWarningPermissionsPolicyMissing,
+					internal.SecurityWarningCookieInsecure,
+					internal.SecurityWarningCookieNoHTTPOnly,
+					internal.SecurityWarningCookieNoSameSite,
+				},
+			},
+		},
+		{
+			"OK: HTTP",
+			FetchResult{
+				Header: http.Header{
+					"Strict-Transport-Security": []string{"max-age=0"},
+				},
+			},
+			internal.Security{
+				Grade: internal.SecurityGradeF,
+				Score: 20,
+				HSTS:  internal.HSTS{Present: true},
+				CSP: internal.CSP{
+					Directives: map[string][]string{},
+				},
+				Cookies: []internal.SecurityCookie{},
+				Warnings: []internal.SecurityWarning{
+					internal.SecurityWarningTLSMissing,
+					internal.SecurityWarningCSPMissing,
+					internal.SecurityWarningXFrameOptionsMissing,
+					internal.SecurityWarningXContentTypeOptionsMissing,
+					internal.SecurityWarningReferrerPolicyMissing,
+					internal.SecurityWarningPermissionsPolicyMissing,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := detectSecurity(tt.input, now)
+
+			if !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestParseCSP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		unsafeInline bool
+	}{
+		{"unsafe-inline", "script-src 'self' 'unsafe-inline'", true},
+		{"unsafe-inline with nonce", "script-src 'self' 'unsafe-inline' 'nonce-r4nd0m'", false},
+		{"unsafe-inline with hash", "script-src 'unsafe-inline' 'sha256-B2yPHKaXnvFWtRChIbabYmUBFZdVfKKXHbWtWidDVF8='", false},
+		{"nonce in another directive", "script-src 'nonce-r4nd0m'; style-src 'unsafe-inline'", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := parseCSP([]string{tt.input}); actual.UnsafeInline != tt.unsafeInline {
+				t.Fatalf("expected unsafe inline %t, actual %t", tt.unsafeInline, actual.UnsafeInline)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+var (
+	// requiredOpenGraph are the properties needed for rendering a shared page
+	requiredOpenGraph = []string{"og:title", "og:type", "og:image", "og:url"}
+
+	// requiredTwitterCard maps the properties needed for rendering a card to their Open Graph fallback
+	requiredTwitterCard = []struct{ property, fallback string }{
+		{"twitter:card", ""},
+		{"twitter:title", "og:title"},
+		{"twitter:description", "og:description"},
+		{"twitter:image", "og:image"},
+	}
+
+	// requiredSchemaFields are the properties schema.org items need for being used by search engines
+	requiredSchemaFields = map[string][]string{
+		"Article":        {"headline", "author", "datePublished"},
+		"BlogPosting":    {"headline", "author", "datePublished"},
+		"NewsArticle":    {"headline", "author", "datePublished"},
+		"BreadcrumbList": {"itemListElement"},
+		"Event":          {"name", "startDate", "location"},
+		"FAQPage":        {"mainEntity"},
+		"JobPosting":     {"title", "description", "datePosted", "hiringOrganization"},
+		"LocalBusiness":  {"name", "address"},
+		"Offer":          {"price", "priceCurrency"},
+		"Organization":   {"name", "url"},
+		"Person":         {"name"},
+		"Product":        {"name"},
+		"Recipe":         {"name", "image", "recipeIngredient"},
+		"Review":         {"itemReviewed", "author"},
+		"VideoObject":    {"name", "thumbnailUrl", "uploadDate"},
+		"WebSite":        {"name", "url"},
+	}
+)
+
+// structuredDataAnalyzer reports the Open Graph, Twitter Card, JSON-LD and microdata of the page as the
+// structuredData section
+type structuredDataAnalyzer struct{}
+
+func (structuredDataAnalyzer) Name() string { return "structuredData" }
+
+func (structuredDataAnalyzer) Concurrent() bool { return true }
+
+func (a structuredDataAnalyzer) Analyze(_ context.Context, page *Page) (Finding, error) {
+	return NewSection(a.Name(), detectStructuredData(page.Document))
+}
+
+func detectStructuredData(doc *goquery.Document) internal.StructuredData {
+	data := internal.StructuredData{
+		OpenGraph:          make(map[string]string),
+		TwitterCard:        make(map[string]string),
+		OpenGraphMissing:   []string{},
+		TwitterCardMissing: []string{},
+		JSONLD:             []internal.JSONLDBlock{},
+		Items:              []internal.StructuredDataItem{},
+	}
+
+	// both are found in the property and the name attributes in the wild
+	doc.Find("meta[content]").Each(func(_ int, item *goquery.Selection) {
+		property, ok := item.Attr("property")
+		if !ok {
+			property, _ = item.Attr("name")
+		}
+
+		property = strings.ToLower(strings.TrimSpace(property))
+		content, _ := item.Attr("content")
+
+		switch {
+		case strings.HasPrefix(property, "og:"):
+			if _, ok := data.OpenGraph[property]; !ok {
+				data.OpenGraph[property] = strings.TrimSpace(content)
+			}
+		case strings.HasPrefix(property, "twitter:"):
+			if _, ok := data.TwitterCard[property]; !ok {
+				data.TwitterCard[property] = strings.TrimSpace(content)
+			}
+		}
+	})
+
+	for _, property := range requiredOpenGraph {
+		if data.OpenGraph[property] == "" {
+			data.OpenGraphMissing = append(data.OpenGraphMissing, property)
+		}
+	}
+
+	for _, required := range requiredTwitterCard {
+		if data.TwitterCard[required.property] == "" && (required.fallback == "" || data.OpenGraph[required.fallback] == "") {
+			data.TwitterCardMissing = append(data.TwitterCardMissing, required.property)
+		}
+	}
+
+	doc.Find("script[type]").Each(func(_ int, item *goquery.Selection) {
+		if typ, _ := item.Attr("type"); !strings.EqualFold(strings.TrimSpace(typ), "application/ld+json") {
+			return
+		}
+
+		block, items := parseJSONLD(item.Text())
+
+		data.JSONLD = append(data.JSONLD, block)
+		data.Items = append(data.Items, items...)
+	})
+
+	// top level microdata items are the ones not being the property of another item
+	doc.Find("[itemscope]").Not("[itemprop]").Each(func(_ int, item *goquery.Selection) {
+		data.Items = append(data.Items, newMicrodataItem(item))
+	})
+
+	return data
+}
+
+// parseJSONLD returns the content of a JSON-LD script and its top level items, the items of a @graph
+// included
+func parseJSONLD(text string) (internal.JSONLDBlock, []internal.StructuredDataItem) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		return internal.JSONLDBlock{Error: err.Error()}, nil
+	}
+
+	var compact bytes.Buffer
+	_ = json.Compact(&compact, []byte(text))
+
+	block := internal.JSONLDBlock{Data: compact.Bytes()}
+
+	var nodes []interface{}
+
+	switch v := value.(type) {
+	case []interface{}:
+		nodes = v
+	case map[string]interface{}:
+		nodes = []interface{}{v}
+	}
+
+	var items []internal.StructuredDataItem
+
+	for len(nodes) > 0 {
+		node, ok := nodes[0].(map[string]interface{})
+		nodes = nodes[1:]
+
+		if !ok {
+			continue
+		}
+
+		if graph, ok := node["@graph"].([]interface{}); ok {
+			nodes = append(nodes, graph...)
+		}
+
+		types := jsonLDTypes(node["@type"])
+		if len(types) == 0 {
+			continue
+		}
+
+		items = append(items, newStructuredDataItem(internal.StructuredDataFormatJSONLD, types, func(field string) bool {
+			return present(node[field])
+		}))
+	}
+
+	return block, items
+}
+
+func jsonLDTypes(value interface{}) []string {
+	var types []string
+
+	switch v := value.(type) {
+	case string:
+		types = append(types, schemaType(v))
+	case []interface{}:
+		for _, t := range v {
+			if s, ok := t.(string); ok {
+				types = append(types, schemaType(s))
+			}
+		}
+	}
+
+	return types
+}
+
+// present indicates whether a JSON-LD property has a value
+func present(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case string:
+		return strings.TrimSpace(v) != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+
+	return true
+}
+
+func newMicrodataItem(item *goquery.Selection) internal.StructuredDataItem {
+	itemtype, _ := item.Attr("itemtype")
+
+	var types []string
+	for _, t := range strings.Fields(itemtype) {
+		types = append(types, schemaType(t))
+	}
+
+	node := item.Get(0)
+	props := make(map[string]bool)
+
+	// the properties of nested items belong to them
+	item.Find("[itemprop]").Each(func(_ int, prop *goquery.Selection) {
+		if prop.Parent().Closest("[itemscope]").Get(0) != node {
+			return
+		}
+
+		names, _ := prop.Attr("itemprop")
+		for _, name := range strings.Fields(names) {
+			props[name] = true
+		}
+	})
+
+	return newStructuredDataItem(internal.StructuredDataFormatMicrodata, types, func(field string) bool {
+		return props[field]
+	})
+}
+
+func newStructuredDataItem(format internal.StructuredDataFormat, types []string, has func(string) bool) internal.StructuredDataItem {
+	item := internal.StructuredDataItem{
+		Format:        format,
+		Types:         types,
+		MissingFields: []string{},
+	}
+
+	if item.Types == nil {
+		item.Types = []string{}
+	}
+
+	seen := make(map[string]bool)
+
+	for _, t := range types {
+		for _, field := range requiredSchemaFields[t] {
+			if !seen[field] && !has(field) {
+				item.MissingFields = append(item.MissingFields, field)
+			}
+
+			seen[field] = true
+		}
+	}
+
+	return item
+}
+
+// schemaType returns the schema.org type without vocabulary, "https://schema.org/Product" and
+// "schema:Product" become "Product"
+func schemaType(t string) string {
+	t = strings.TrimSpace(t)
+
+	for _, prefix := range []string{"https://schema.org/", "http://schema.org/", "schema:"} {
+		if len(t) > len(prefix) && strings.EqualFold(t[:len(prefix)], prefix) {
+			return strings.TrimSuffix(t[len(prefix):], "/")
+		}
+	}
+
+	return t
+}
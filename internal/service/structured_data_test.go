@@ -0,0 +1,101 @@
+package service
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDetectStructuredData(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected internal.StructuredData
+	}{
+		{
+			"OK",
+			`<html><head>
+<meta property="og:title" content="Shoes">
+<meta property="og:type" content="product">
+<meta property="OG:Image" content="https://example.com/shoes.png">
+<meta property="og:image" content="https://example.com/other.png">
+<meta property="og:url" content="https://example.com/shoes">
+<meta name="twitter:card" content="summary_large_image">
+<script type="application/ld+json">
+{
+  "@context": "https://schema.org",
+  "@graph": [
+    {"@type": "Organization", "name": "Example", "url": "https://example.com"},
+    {"@type": ["Product", "schema:Thing"], "name": "", "offers": {"@type": "Offer", "price": "10"}}
+  ]
+}
+</script>
+<script type="application/ld+json">{"@type": "Article", "headline": "Shoes",</script>
+<script type="text/javascript">var x = {"@type": "Person"};</script>
+</head><body>
+<div itemscope itemtype="https://schema.org/Event">
+  <span itemprop="name">Sale</span>
+  <div itemprop="location" itemscope itemtype="https://schema.org/Place"><span itemprop="startDate">not the event's</span></div>
+</div>
+</body></html>`,
+			internal.StructuredData{
+				OpenGraph: map[string]string{
+					"og:title": "Shoes",
+					"og:type":  "product",
+					"og:image": "https://example.com/shoes.png",
+					"og:url":   "https://example.com/shoes",
+				},
+				TwitterCard:        map[string]string{"twitter:card": "summary_large_image"},
+				OpenGraphMissing:   []string{},
+				TwitterCardMissing: []string{"twitter:description"},
+				JSONLD: []internal.JSONLDBlock{
+					{Data: json.RawMessage(`{"@context":"https://schema.org","@graph":[{"@type":"Organization","name":"Example","url":"https://example.com"},{"@type":["Product","schema:Thing"],"name":"","offers":{"@type":"Offer","price":"10"}}]}`)},
+					{Error: "unexpected end of JSON input"},
+				},
+				Items: []internal.StructuredDataItem{
+					{Format: internal.StructuredDataFormatJSONLD, Types: []string{"Organization"}, MissingFields: []string{}},
+					{Format: internal.StructuredDataFormatJSONLD, Types: []string{"Product", "Thing"}, MissingFields: []string{"name"}},
+					{Format: internal.StructuredDataFormatMicrodata, Types: []string{"Event"}, MissingFields: []string{"startDate"}},
+				},
+			},
+		},
+		{
+			"OK: none",
+			`<html><head><title>Plain</title></head><body></body></html>`,
+			internal.StructuredData{
+				OpenGraph:          map[string]string{},
+				TwitterCard:        map[string]string{},
+				OpenGraphMissing:   []string{"og:title", "og:type", "og:image", "og:url"},
+				TwitterCardMissing: []string{"twitter:card", "twitter:title", "twitter:description", "twitter:image"},
+				JSONLD:             []internal.JSONLDBlock{},
+				Items:              []internal.StructuredDataItem{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual := detectStructuredData(doc)
+
+			if !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
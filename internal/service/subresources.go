@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+var (
+	fontFaceRegEx = regexp.MustCompile(`(?is)@font-face\s*\{[^}]*\}`)
+	cssURLRegEx   = regexp.MustCompile(`(?i)url\(\s*['"]?([^'")]+)['"]?\s*\)`)
+)
+
+// subresourcesAnalyzer inventories the resources loaded by the page as the subresources section, their
+// availability is checked when checker is set
+type subresourcesAnalyzer struct {
+	checker *LinkChecker
+}
+
+func (subresourcesAnalyzer) Name() string { return "subresources" }
+
+func (subresourcesAnalyzer) Concurrent() bool { return true }
+
+func (a subresourcesAnalyzer) Analyze(ctx context.Context, page *Page) (Finding, error) {
+	subresources := detectSubresources(page)
+
+	if a.checker != nil {
+		hrefs := make([]string, len(subresources.Items))
+		for i, item := range subresources.Items {
+			hrefs[i] = item.URL
+		}
+
+		for i, result := range a.checker.Check(ctx, page.Base, hrefs) {
+			link := newLink(page.Fetch.URL, "", result)
+
+			subresources.Items[i].StatusClass = link.StatusClass()
+			subresources.Items[i].StatusCode = link.StatusCode
+			subresources.Items[i].Error = link.Error
+		}
+
+		subresources.Checked = true
+	}
+
+	return NewSection(a.Name(), subresources)
+}
+
+// subresourceRef is a reference found in the document before being resolved
+type subresourceRef struct {
+	typ       internal.SubresourceType
+	href      string
+	integrity string
+}
+
+func detectSubresources(page *Page) internal.Subresources {
+	doc := page.Document
+
+	var refs []subresourceRef
+
+	collect := func(selector, attr string, typ internal.SubresourceType) {
+		doc.Find(selector).Each(func(_ int, item *goquery.Selection) {
+			refs = append(refs, newSubresourceRef(item, attr, typ))
+		})
+	}
+
+	collect("script[src]", "src", internal.SubresourceTypeScript)
+
+	doc.Find("link[rel][href]").Each(func(_ int, item *goquery.Selection) {
+		rel, _ := item.Attr("rel")
+		rels := strings.Fields(strings.ToLower(rel))
+		as, _ := item.Attr("as")
+
+		switch {
+		case hasToken(rels, "stylesheet"):
+			refs = append(refs, newSubresourceRef(item, "href", internal.SubresourceTypeStylesheet))
+		case hasToken(rels, "preload") && strings.EqualFold(strings.TrimSpace(as), "font"):
+			refs = append(refs, newSubresourceRef(item, "href", internal.SubresourceTypeFont))
+		}
+	})
+
+	doc.Find("style").Each(func(_ int, item *goquery.Selection) {
+		for _, href := range fontFaceURLs(item.Text()) {
+			refs = append(refs, subresourceRef{typ: internal.SubresourceTypeFont, href: href})
+		}
+	})
+
+	collect("img[src], input[type=image][src]", "src", internal.SubresourceTypeImage)
+
+	doc.Find("img[srcset], picture source[srcset]").Each(func(_ int, item *goquery.Selection) {
+		srcset, _ := item.Attr("srcset")
+		for _, href := range srcsetURLs(srcset) {
+			refs = append(refs, subresourceRef{typ: internal.SubresourceTypeImage, href: href})
+		}
+	})
+
+	collect("video[poster]", "poster", internal.SubresourceTypeImage)
+	collect("iframe[src], frame[src]", "src", internal.SubresourceTypeIframe)
+	collect("video[src], audio[src], video source[src], audio source[src], track[src]", "src", internal.SubresourceTypeMedia)
+
+	subresources := internal.Subresources{
+		Items: []internal.Subresource{},
+	}
+
+	pageURL := page.Fetch.URL
+	seen := make(map[subresourceRef]bool)
+
+	for _, ref := range refs {
+		// data: URLs and the like are not loaded from anywhere
+		target, skip, err := resolveLink(page.Base, ref.href)
+		if skip || err != nil {
+			continue
+		}
+
+		key := subresourceRef{typ: ref.typ, href: target}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		item := newSubresource(pageURL, ref, target)
+
+		if item.ThirdParty {
+			subresources.ThirdPartyCount++
+		}
+
+		if item.MixedContent {
+			subresources.MixedContentCount++
+		}
+
+		if item.MissingIntegrity {
+			subresources.MissingIntegrityCount++
+		}
+
+		subresources.Items = append(subresources.Items, item)
+	}
+
+	return subresources
+}
+
+func newSubresourceRef(item *goquery.Selection, attr string, typ internal.SubresourceType) subresourceRef {
+	href, _ := item.Attr(attr)
+	integrity, _ := item.Attr("integrity")
+
+	return subresourceRef{
+		typ:       typ,
+		href:      href,
+		integrity: strings.TrimSpace(integrity),
+	}
+}
+
+func newSubresource(pageURL *url.URL, ref subresourceRef, target string) internal.Subresource {
+	item := internal.Subresource{
+		Type:      ref.typ,
+		URL:       target,
+		Integrity: ref.integrity,
+	}
+
+	if u, err := url.Parse(target); err == nil {
+		item.ThirdParty = registrableDomain(u.Hostname()) != registrableDomain(pageURL.Hostname())
+		item.MixedContent = strings.EqualFold(pageURL.Scheme, "https") && u.Scheme == "http"
+	}
+
+	item.MissingIntegrity = ref.typ == internal.SubresourceTypeScript && item.ThirdParty && ref.integrity == ""
+
+	return item
+}
+
+// srcsetURLs returns the URLs of the image candidates of a srcset attribute. As done by the HTML spec a URL
+// runs up to the next whitespace, so it may contain commas, and its descriptors up to the next comma.
+func srcsetURLs(srcset string) []string {
+	var hrefs []string
+
+	for i := 0; i < len(srcset); {
+		for i < len(srcset) && (isSrcsetSpace(srcset[i]) || srcset[i] == ',') {
+			i++
+		}
+
+		start := i
+		for i < len(srcset) && !isSrcsetSpace(srcset[i]) {
+			i++
+		}
+
+		href := srcset[start:i]
+
+		// trailing commas end the candidate, it has no descriptors
+		if trimmed := strings.TrimRight(href, ","); trimmed != href {
+			href = trimmed
+		} else {
+			i = skipSrcsetDescriptors(srcset, i)
+		}
+
+		if href != "" {
+			hrefs = append(hrefs, href)
+		}
+	}
+
+	return hrefs
+}
+
+// skipSrcsetDescriptors returns the position after the comma ending the descriptors starting at i, commas
+// within parentheses don't end them
+func skipSrcsetDescriptors(srcset string, i int) int {
+	depth := 0
+
+	for ; i < len(srcset); i++ {
+		switch srcset[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return i
+}
+
+func isSrcsetSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\f' || c == '\r'
+}
+
+// fontFaceURLs returns the URLs of the @font-face rules of a style sheet
+func fontFaceURLs(css string) []string {
+	var hrefs []string
+
+	for _, rule := range fontFaceRegEx.FindAllString(css, -1) {
+		for _, match := range cssURLRegEx.FindAllStringSubmatch(rule, -1) {
+			hrefs = append(hrefs, strings.TrimSpace(match[1]))
+		}
+	}
+
+	return hrefs
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDetectSubresources(t *testing.T) {
+	t.Parallel()
+
+	input := `<html><head>
+<link rel="stylesheet" href="/main.css">
+<link rel="preload" as="font" href="https://fonts.example.net/a.woff2" crossorigin>
+<link rel="icon" href="/favicon.ico">
+<style>@font-face { font-family: B; src: url("/b.woff2") format("woff2"), url(/b.woff) format("woff"); }
+body { background: url(/bg.png); }</style>
+<script src="https://cdn.example.net/lib.js"></script>
+<script src="https://cdn.example.net/safe.js" integrity="sha384-abc"></script>
+<script src="http://static.example.com/app.js"></script>
+<script>inline()</script>
+</head><body>
+<img src="/logo.png" srcset="data:image/png;base64,iVBORw0KGgo= 1x, /logo.png 1x, /logo@2x.png 2x"><img src="data:image/png;base64,AAAA">
+<picture><source srcset="https://img.example.net/hero.webp"></picture>
+<iframe src="https://www.youtube.com/embed/abc"></iframe>
+<video src="/intro.mp4" poster="/intro.jpg"><track src="/intro.vtt"></video>
+</body></html>`
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	pageURL, _ := url.Parse("https://www.example.com/docs/")
+
+	actual := detectSubresources(&Page{
+		Fetch:    FetchResult{URL: pageURL},
+		Document: doc,
+		Base:     pageURL,
+	})
+
+	expected := internal.Subresources{
+		Items: []internal.Subresource{
+			{Type: internal.SubresourceTypeScript, URL: "https://cdn.example.net/lib.js", ThirdParty: true, MissingIntegrity: true},
+			{Type: internal.SubresourceTypeScript, URL: "https://cdn.example.net/safe.js", ThirdParty: true, Integrity: "sha384-abc"},
+			{Type: internal.SubresourceTypeScript, URL: "http://static.example.com/app.js", MixedContent: true},
+			{Type: internal.SubresourceTypeStylesheet, URL: "https://www.example.com/main.css"},
+			{Type: internal.SubresourceTypeFont, URL: "https://fonts.example.net/a.woff2", ThirdParty: true},
+			{Type: internal.SubresourceTypeFont, URL: "https://www.example.com/b.woff2"},
+			{Type: internal.SubresourceTypeFont, URL: "https://www.example.com/b.woff"},
+			{Type: internal.SubresourceTypeImage, URL: "https://www.example.com/logo.png"},
+			{Type: internal.SubresourceTypeImage, URL: "https://www.example.com/logo@2x.png"},
+			{Type: internal.SubresourceTypeImage, URL: "https://img.example.net/hero.webp", ThirdParty: true},
+			{Type: internal.SubresourceTypeImage, URL: "https://www.example.com/intro.jpg"},
+			{Type: internal.SubresourceTypeIframe, URL: "https://www.youtube.com/embed/abc", ThirdParty: true},
+			{Type: internal.SubresourceTypeMedia, URL: "https://www.example.com/intro.mp4"},
+			{Type: internal.SubresourceTypeMedia, URL: "https://www.example.com/intro.vtt"},
+		},
+		ThirdPartyCount:       5,
+		MixedContentCount:     1,
+		MissingIntegrityCount: 1,
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestSrcsetURLs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"OK", "/a.png 1x, /b.png 2x", []string{"/a.png", "/b.png"}},
+		{"OK: no descriptors", "/a.png, /b.png,", []string{"/a.png", "/b.png"}},
+		{"OK: data URL", "data:image/png;base64,iVBORw0KGgo= 1x, /b.png 2x", []string{"data:image/png;base64,iVBORw0KGgo=", "/b.png"}},
+		{"OK: comma in URL", "/img/w_100,h_50/a.jpg 100w, /img/w_200,h_100/a.jpg 200w", []string{"/img/w_100,h_50/a.jpg", "/img/w_200,h_100/a.jpg"}},
+		{"OK: parenthesized descriptor", "/a.png (a, b) 1x, /b.png", []string{"/a.png", "/b.png"}},
+		{"OK: blank", " , ", nil},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := srcsetURLs(tt.input); !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestSubresourcesAnalyzer_Analyze(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/app.js" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<script src="/app.js"></script><img src="/missing.png">`))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	pageURL, _ := url.Parse(srv.URL + "/")
+
+	analyzer := subresourcesAnalyzer{checker: NewLinkChecker(srv.Client(), nil, LinkCheckerConfig{})}
+
+	finding, err := analyzer.Analyze(context.Background(), &Page{
+		Fetch:    FetchResult{URL: pageURL},
+		Document: doc,
+		Base:     pageURL,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var analysis Analysis
+
+	finding.Apply(&analysis)
+
+	var actual internal.Subresources
+	if err := json.Unmarshal(analysis.URL.Sections["subresources"], &actual); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := internal.Subresources{
+		Items: []internal.Subresource{
+			{Type: internal.SubresourceTypeScript, URL: srv.URL + "/app.js", StatusClass: internal.LinkStatusClass2xx, StatusCode: 200},
+			{Type: internal.SubresourceTypeImage, URL: srv.URL + "/missing.png", StatusClass: internal.LinkStatusClass4xx, StatusCode: 404},
+		},
+		Checked: true,
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
@@ -1,107 +1,302 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"mime"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/net/publicsuffix"
 
 	"github.com/Oguzyildirim/url-info/internal"
 )
 
-var doctypes = make(map[string]string)
-
-func init() {
-	doctypes["HTML 4.01 Strict"] = `"-//W3C//DTD HTML 4.01//EN"`
-	doctypes["HTML 4.01 Transitional"] = `"-//W3C//DTD HTML 4.01 Transitional//EN"`
-	doctypes["HTML 4.01 Frameset"] = `"-//W3C//DTD HTML 4.01 Frameset//EN"`
-	doctypes["XHTML 1.0 Strict"] = `"-//W3C//DTD XHTML 1.0 Strict//EN"`
-	doctypes["XHTML 1.0 Transitional"] = `"-//W3C//DTD XHTML 1.0 Transitional//EN"`
-	doctypes["XHTML 1.0 Frameset"] = `"-//W3C//DTD XHTML 1.0 Frameset//EN"`
-	doctypes["XHTML 1.1"] = `"-//W3C//DTD XHTML 1.1//EN"`
-	doctypes["HTML 5"] = `<!DOCTYPE html>`
-}
-
 // URLRepository defines the datastore handling persisting URL records
 type URLRepository interface {
-	Create(ctx context.Context, HTMLVersion string, pageTitle string, headingsCount string, linksCount int,
-		inaccessibleLinksCount int, haveLoginForm bool) (internal.URL, error)
+	Create(ctx context.Context, URL internal.URL, links []internal.Link) (internal.URL, error)
 	Delete(ctx context.Context, id string) error
 	Find(ctx context.Context, id string) (internal.URL, error)
+	FindLatest(ctx context.Context, normalizedURL string, since time.Time) (internal.URL, error)
+	FindLinks(ctx context.Context, id string, class internal.LinkStatusClass) ([]internal.Link, error)
+	History(ctx context.Context, normalizedURL string) ([]internal.URL, error)
+	List(ctx context.Context, params internal.ListURLsParams) (internal.ListURLsResult, error)
+	ListUnnormalized(ctx context.Context, afterID string, limit int) ([]internal.URL, error)
+	UpdateNormalizedURL(ctx context.Context, id string, normalizedURL string) error
 }
 
+// defaultListURLsLimit is the page size used when the caller does not specify one
+const defaultListURLsLimit = 20
+
+// backfillBatchSize is the number of records normalized per query by BackfillNormalizedURLs
+const backfillBatchSize = 100
+
 // URL defines the application service in charge of interacting with URLs
 type URL struct {
-	repo URLRepository
+	repo        URLRepository
+	jobs        JobRepository
+	normalizer  *Normalizer
+	fetcher     *Fetcher
+	analyzers   *AnalyzerRegistry
+	cacheMaxAge time.Duration
+	inflight    *coalescer
+	webhooks    *Webhook
 }
 
-// NewURL
-func NewURL(repo URLRepository) *URL {
+// NewURL instantiates the URL service, fetched pages are inspected by the analyzers. Stored analyses younger
+// than cacheMaxAge are reused by default and the outcome of every analysis is notified to the webhooks.
+func NewURL(repo URLRepository, jobs JobRepository, normalizer *Normalizer, fetcher *Fetcher, analyzers *AnalyzerRegistry, cacheMaxAge time.Duration, webhooks *Webhook) *URL {
 	return &URL{
-		repo: repo,
+		repo:        repo,
+		jobs:        jobs,
+		normalizer:  normalizer,
+		fetcher:     fetcher,
+		analyzers:   analyzers,
+		cacheMaxAge: cacheMaxAge,
+		inflight:    newCoalescer(),
+		webhooks:    webhooks,
 	}
 }
 
-// Create stores a new record
-func (u *URL) Search(ctx context.Context, URL string) (internal.URL, error) {
-	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.Create")
+// Search analyzes the URL and stores the result, a stored analysis of the same normalized URL younger than
+// the max age is returned instead. Concurrent searches of the same normalized URL share one analysis.
+func (u *URL) Search(ctx context.Context, params internal.SearchParams) (internal.SearchResult, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.Search")
 	defer span.End()
 
-	// do crawling here
-	resp, err := http.Get(URL)
+	if err := params.Validate(); err != nil {
+		return internal.SearchResult{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
 
+	normalizedURL, err := u.normalizer.Normalize(params.URL)
 	if err != nil {
-		return internal.URL{}, err
+		return internal.SearchResult{}, fmt.Errorf("normalizer normalize: %w", err)
 	}
 
-	defer resp.Body.Close()
+	span.SetAttributes(attribute.String("url.normalized", normalizedURL))
 
-	if resp.StatusCode != 200 {
-		return internal.URL{}, errors.New("Error Retrieving Document")
+	maxAge := u.cacheMaxAge
+	if params.MaxAge != nil {
+		maxAge = *params.MaxAge
 	}
 
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if maxAge > 0 {
+		URL, err := u.repo.FindLatest(ctx, normalizedURL, time.Now().Add(-maxAge))
+		if err == nil {
+			span.SetAttributes(attribute.Bool("cache.hit", true))
 
+			return internal.SearchResult{URL: URL, Cached: true}, nil
+		}
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			return internal.SearchResult{}, fmt.Errorf("repo find latest: %w", err)
+		}
+	}
+
+	URL, shared, err := u.inflight.do(ctx, normalizedURL, func(ctx context.Context) (internal.URL, error) {
+		return u.analyze(ctx, params.URL, normalizedURL)
+	})
 	if err != nil {
-		return internal.URL{}, fmt.Errorf("NewDocumentFromReader: %w", err)
+		return internal.SearchResult{}, err
 	}
 
-	html, err := doc.Html()
+	span.SetAttributes(attribute.Bool("cache.hit", false), attribute.Bool("coalesced", shared))
+
+	return internal.SearchResult{URL: URL}, nil
+}
+
+// analyze fetches the normalized URL, runs the analyzers on it and stores the result
+func (u *URL) analyze(ctx context.Context, URL, normalizedURL string) (internal.URL, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.analyze")
+	defer span.End()
 
+	res, err := u.fetcher.Fetch(ctx, normalizedURL)
 	if err != nil {
-		return internal.URL{}, fmt.Errorf("doc.Html: %w", err)
+		if ctx.Err() == nil {
+			u.notify(ctx, internal.WebhookEventFetchFailed, fetchFailedEventData{
+				URL:           URL,
+				NormalizedURL: normalizedURL,
+				Error:         err.Error(),
+			})
+		}
+
+		return internal.URL{}, fmt.Errorf("fetcher fetch: %w", err)
 	}
 
-	// get html version
-	htmlVersion := detectHTMLVersion(html)
+	if res.StatusCode != http.StatusOK {
+		err := internal.NewUpstreamStatusErrorf(res.StatusCode, "upstream responded with status %d", res.StatusCode)
 
-	// get page title
-	pageTitle := detectPageTitle(doc)
+		u.notify(ctx, internal.WebhookEventFetchFailed, fetchFailedEventData{
+			URL:           URL,
+			NormalizedURL: normalizedURL,
+			StatusCode:    res.StatusCode,
+			Error:         err.Error(),
+		})
 
-	// get headings count by level
-	headings := detectHeadingsCountByLevel(doc)
+		return internal.URL{}, err
+	}
 
-	// get internal links
-	linksCount := detectLinks(doc)
+	if err := checkContentType(res); err != nil {
+		return internal.URL{}, err
+	}
 
-	// get internal links
-	inaccessibleLinksCount := detectInaccessibleLinksCount(doc)
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(res.Body))
 
-	// get internal links
-	haveLoginForm := detectHaveLoginForm(doc)
+	if err != nil {
+		return internal.URL{}, fmt.Errorf("NewDocumentFromReader: %w", err)
+	}
 
-	info, err := u.repo.Create(ctx, htmlVersion, pageTitle, headings, linksCount, inaccessibleLinksCount, haveLoginForm)
+	analysis := Analysis{
+		URL: internal.URL{
+			URL:           URL,
+			NormalizedURL: normalizedURL,
+			FinalURL:      res.URL.String(),
+			Redirects:     res.Redirects,
+			StatusCode:    res.StatusCode,
+			ContentType:   res.Header.Get("Content-Type"),
+			ContentLength: int64(len(res.Body)),
+			FetchDuration: res.Duration,
+			CreatedAt:     res.FetchedAt,
+		},
+	}
+
+	page := &Page{
+		Fetch:    res,
+		Document: doc,
+		Base:     detectBaseURL(doc, res.URL),
+	}
+
+	if err := u.analyzers.Run(ctx, page, &analysis); err != nil {
+		return internal.URL{}, fmt.Errorf("analyzers run: %w", err)
+	}
+
+	info := analysis.URL
+
+	// the previous analysis is looked up before storing this one, which would be the latest otherwise
+	previous, err := u.repo.FindLatest(ctx, normalizedURL, time.Time{})
+	if err != nil {
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			span.RecordError(err)
+		}
+
+		previous = internal.URL{}
+	}
+
+	info, err = u.repo.Create(ctx, info, analysis.Links)
 	if err != nil {
 		return internal.URL{}, fmt.Errorf("repo create: %w", err)
 	}
+
+	u.notifyAnalysis(ctx, info, previous)
+
 	return info, nil
 }
 
+// notifyAnalysis sends the events of a stored analysis, the ones comparing it with the previous analysis of
+// the same normalized URL are skipped when there is none
+func (u *URL) notifyAnalysis(ctx context.Context, URL, previous internal.URL) {
+	data := newAnalysisEventData(URL)
+
+	if previous.ID == "" {
+		u.notify(ctx, internal.WebhookEventAnalysisCompleted, data)
+		return
+	}
+
+	data.Previous = newAnalysisEventData(previous)
+
+	u.notify(ctx, internal.WebhookEventAnalysisCompleted, data)
+
+	if URL.InaccessibleLinksCount > previous.InaccessibleLinksCount {
+		u.notify(ctx, internal.WebhookEventLinksBrokenIncreased, data)
+	}
+
+	if URL.PageTitle != previous.PageTitle {
+		u.notify(ctx, internal.WebhookEventTitleChanged, data)
+	}
+}
+
+// notify enqueues the event, failing to do so does not fail the analysis
+func (u *URL) notify(ctx context.Context, event internal.WebhookEvent, data interface{}) {
+	if err := u.webhooks.Notify(ctx, event, data); err != nil {
+		trace.SpanFromContext(ctx).RecordError(err)
+	}
+}
+
+// BackfillNormalizedURLs sets the normalized URL of the records stored before it was computed, the URL is
+// kept as is when the normalizer rejects it. It returns the number of records updated.
+func (u *URL) BackfillNormalizedURLs(ctx context.Context) (int, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.BackfillNormalizedURLs")
+	defer span.End()
+
+	var (
+		afterID string
+		count   int
+	)
+
+	for {
+		URLs, err := u.repo.ListUnnormalized(ctx, afterID, backfillBatchSize)
+		if err != nil {
+			return count, fmt.Errorf("repo list unnormalized: %w", err)
+		}
+
+		for _, URL := range URLs {
+			normalizedURL, err := u.normalizer.Normalize(URL.URL)
+			if err != nil {
+				normalizedURL = URL.URL
+			}
+
+			if err := u.repo.UpdateNormalizedURL(ctx, URL.ID, normalizedURL); err != nil {
+				return count, fmt.Errorf("repo update normalized URL: %w", err)
+			}
+
+			count++
+		}
+
+		if len(URLs) < backfillBatchSize {
+			return count, nil
+		}
+
+		afterID = URLs[len(URLs)-1].ID
+	}
+}
+
+// Shutdown cancels the analyses still running for searches and waits for them to return until ctx is done
+func (u *URL) Shutdown(ctx context.Context) error {
+	return u.inflight.shutdown(ctx)
+}
+
+// Enqueue stores a new job searching the URL in the background, MaxAge is kept for when the job runs
+func (u *URL) Enqueue(ctx context.Context, params internal.SearchParams) (internal.Job, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.Enqueue")
+	defer span.End()
+
+	if err := params.Validate(); err != nil {
+		return internal.Job{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
+
+	if _, err := u.normalizer.Normalize(params.URL); err != nil {
+		return internal.Job{}, fmt.Errorf("normalizer normalize: %w", err)
+	}
+
+	job, err := u.jobs.Create(ctx, params)
+	if err != nil {
+		return internal.Job{}, fmt.Errorf("jobs create: %w", err)
+	}
+
+	return job, nil
+}
+
 // Delete removes an existing URL from the datastore
 func (u *URL) Delete(ctx context.Context, id string) error {
 	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.Delete")
@@ -127,99 +322,435 @@ func (u *URL) Find(ctx context.Context, id string) (internal.URL, error) {
 	return URL, nil
 }
 
-func detectHTMLVersion(html string) string {
-	version := "UNKNOWN"
+// FindLinks gets the checked links of an existing URL, an empty class returns all of them
+func (u *URL) FindLinks(ctx context.Context, id string, class internal.LinkStatusClass) ([]internal.Link, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.FindLinks")
+	defer span.End()
 
-	for doctype, matcher := range doctypes {
-		match := strings.Contains(html, matcher)
+	if class != "" {
+		if err := class.Validate(); err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "class.Validate")
+		}
+	}
+
+	if _, err := u.repo.Find(ctx, id); err != nil {
+		return nil, fmt.Errorf("repo find: %w", err)
+	}
 
-		if match == true {
-			version = doctype
+	links, err := u.repo.FindLinks(ctx, id, class)
+	if err != nil {
+		return nil, fmt.Errorf("repo find links: %w", err)
+	}
+
+	return links, nil
+}
+
+// FindAccessibilityIssues gets the accessibility issues of an existing URL matching the params, analyses made
+// without the accessibility analyzer are not found
+func (u *URL) FindAccessibilityIssues(ctx context.Context, id string, params internal.AccessibilityIssuesParams) ([]internal.AccessibilityIssue, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.FindAccessibilityIssues")
+	defer span.End()
+
+	if err := params.Validate(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
+
+	URL, err := u.repo.Find(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo find: %w", err)
+	}
+
+	raw, ok := URL.Sections[accessibilityAnalyzer{}.Name()]
+	if !ok {
+		return nil, internal.NewErrorf(internal.ErrorCodeNotFound, "accessibility not analyzed")
+	}
+
+	var accessibility internal.Accessibility
+	if err := json.Unmarshal(raw, &accessibility); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Unmarshal")
+	}
+
+	issues := []internal.AccessibilityIssue{}
+
+	for _, issue := range accessibility.Issues {
+		if params.Match(issue) {
+			issues = append(issues, issue)
 		}
 	}
 
-	return version
+	return issues, nil
 }
 
-func detectPageTitle(query *goquery.Document) string {
-	title := query.Find("title").Contents()
-	return title.Text()
+// History returns all the analyses of the normalized URL, oldest first
+func (u *URL) History(ctx context.Context, URL string) ([]internal.URL, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.History")
+	defer span.End()
+
+	normalizedURL, err := u.normalizer.Normalize(URL)
+	if err != nil {
+		return nil, fmt.Errorf("normalizer normalize: %w", err)
+	}
+
+	URLs, err := u.repo.History(ctx, normalizedURL)
+	if err != nil {
+		return nil, fmt.Errorf("repo history: %w", err)
+	}
+
+	return URLs, nil
 }
 
-func detectHeadingsCountByLevel(query *goquery.Document) string {
-	h1 := query.Find("h1")
-	h1Count := h1.Length()
-	header1 := fmt.Sprintf("%s%d", "h1: ", h1Count)
+// Diff compares two analyses of the same normalized URL, from the one matching fromID to the one matching toID
+func (u *URL) Diff(ctx context.Context, fromID, toID string) (internal.URLDiff, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.Diff")
+	defer span.End()
+
+	from, err := u.repo.Find(ctx, fromID)
+	if err != nil {
+		return internal.URLDiff{}, fmt.Errorf("repo find: %w", err)
+	}
+
+	to, err := u.repo.Find(ctx, toID)
+	if err != nil {
+		return internal.URLDiff{}, fmt.Errorf("repo find: %w", err)
+	}
+
+	if from.NormalizedURL != to.NormalizedURL {
+		return internal.URLDiff{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "analyses are not of the same URL")
+	}
+
+	fromLinks, err := u.repo.FindLinks(ctx, fromID, "")
+	if err != nil {
+		return internal.URLDiff{}, fmt.Errorf("repo find links: %w", err)
+	}
+
+	toLinks, err := u.repo.FindLinks(ctx, toID, "")
+	if err != nil {
+		return internal.URLDiff{}, fmt.Errorf("repo find links: %w", err)
+	}
 
-	h2 := query.Find("h2")
-	h2Count := h2.Length()
-	header2 := fmt.Sprintf("%s%d", "h2: ", h2Count)
+	return diffURLs(from, to, fromLinks, toLinks), nil
+}
+
+// List returns a page of existing URLs from the datastore
+func (u *URL) List(ctx context.Context, params internal.ListURLsParams) (internal.ListURLsResult, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("URLTracer").Start(ctx, "URL.List")
+	defer span.End()
 
-	h3 := query.Find("h3")
-	h3Count := h3.Length()
-	header3 := fmt.Sprintf("%s%d", "h3: ", h3Count)
+	if params.Sort == "" {
+		params.Sort = internal.URLSortCreatedAt
+	}
 
-	h4 := query.Find("h4")
-	h4Count := h4.Length()
-	header4 := fmt.Sprintf("%s%d", "h4: ", h4Count)
+	if params.Limit == 0 {
+		params.Limit = defaultListURLsLimit
+	}
 
-	h5 := query.Find("h5")
-	h5Count := h5.Length()
-	header5 := fmt.Sprintf("%s%d", "h5: ", h5Count)
+	if err := params.Validate(); err != nil {
+		return internal.ListURLsResult{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
 
-	h6 := query.Find("h6")
-	h6Count := h6.Length()
-	header6 := fmt.Sprintf("%s %d", "h6:  ", h6Count)
+	res, err := u.repo.List(ctx, params)
+	if err != nil {
+		return internal.ListURLsResult{}, fmt.Errorf("repo list: %w", err)
+	}
 
-	return header1 + "  " + header2 + "  " + header3 + "  " + header4 + "  " + header5 + "  " + header6
+	return res, nil
+}
+
+// checkContentType rejects documents other than HTML, the type is sniffed when the server does not send one
+func checkContentType(res FetchResult) error {
+	contentType := res.Header.Get("Content-Type")
+	if contentType == "" {
+		contentType = http.DetectContentType(res.Body)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnsupportedContentType, "invalid content type %q", contentType)
+	}
+
+	switch mediaType {
+	case "text/html", "application/xhtml+xml":
+		return nil
+	}
+
+	return internal.NewErrorf(internal.ErrorCodeUnsupportedContentType, "unsupported content type %q", mediaType)
+}
+
+func detectPageTitle(query *goquery.Document) string {
+	title := query.Find("title").Contents()
+	return title.Text()
+}
+
+func detectHeadings(query *goquery.Document) internal.Headings {
+	headings := internal.Headings{
+		Outline: []internal.Heading{},
+	}
+
+	query.Find("h1, h2, h3, h4, h5, h6").Each(func(index int, item *goquery.Selection) {
+		level := int(goquery.NodeName(item)[1] - '0')
+
+		switch level {
+		case 1:
+			headings.H1++
+		case 2:
+			headings.H2++
+		case 3:
+			headings.H3++
+		case 4:
+			headings.H4++
+		case 5:
+			headings.H5++
+		case 6:
+			headings.H6++
+		}
+
+		headings.Outline = append(headings.Outline, internal.Heading{
+			Level: level,
+			Text:  strings.Join(strings.Fields(item.Text()), " "),
+		})
+	})
+
+	return headings
 }
 
 func detectLinks(query *goquery.Document) int {
 	return query.Find("body a").Length()
 }
 
-func detectInaccessibleLinksCount(query *goquery.Document) int {
-	var links []string
-	query.Find("body a").Each(func(index int, item *goquery.Selection) {
-		linkTag := item
-		link, _ := linkTag.Attr("href")
-		links = append(links, link)
+// anchor is a link of the page as written in the document
+type anchor struct {
+	href   string
+	text   string
+	rel    string
+	target string
+}
+
+func detectAnchors(query *goquery.Document) []anchor {
+	var anchors []anchor
+	query.Find("body a[href]").Each(func(index int, item *goquery.Selection) {
+		href, _ := item.Attr("href")
+		rel, _ := item.Attr("rel")
+		target, _ := item.Attr("target")
+		anchors = append(anchors, anchor{
+			href:   href,
+			text:   strings.Join(strings.Fields(item.Text()), " "),
+			rel:    strings.ToLower(rel),
+			target: strings.ToLower(strings.TrimSpace(target)),
+		})
 	})
+	return anchors
+}
 
-	var inaccessibleLinksCount int
-	accessChan := make(chan bool)
+// newLink converts the result of checking a link, links pointing to another registrable domain than the
+// page are external
+func newLink(pageURL *url.URL, text string, result LinkResult) internal.Link {
+	link := internal.Link{
+		Href:        result.Href,
+		ResolvedURL: result.URL,
+		Text:        text,
+		Skipped:     result.Skipped,
+		StatusCode:  result.StatusCode,
+		Latency:     result.Latency,
+	}
 
-	for _, value := range links {
-		go isAccessible(value, accessChan)
+	if result.Err != nil {
+		link.Error = result.Err.Error()
 	}
 
-	for {
-		select {
-		case isAccessible := <-accessChan:
-			if !isAccessible {
-				inaccessibleLinksCount++
-			}
-		case <-time.After(time.Millisecond * 10):
-			return inaccessibleLinksCount
-		}
+	if target, err := url.Parse(result.URL); err == nil && result.URL != "" {
+		link.External = registrableDomain(target.Hostname()) != registrableDomain(pageURL.Hostname())
 	}
+
+	return link
 }
 
-func isAccessible(url string, accessChan chan<- bool) {
-	_, err := http.Get(url)
-	if err != nil {
-		accessChan <- false
+// countLink adds the link to the link counters of the page
+func countLink(info *internal.URL, anchor anchor, result LinkResult, link internal.Link) {
+	if !result.Accessible() {
+		info.InaccessibleLinksCount++
+	}
+
+	// links disallowed by robots.txt are skipped but still resolved
+	if link.ResolvedURL != "" {
+		if link.External {
+			info.ExternalLinksCount++
+		} else {
+			info.InternalLinksCount++
+		}
+	}
+
+	rels := strings.Fields(anchor.rel)
+
+	if hasToken(rels, "nofollow") {
+		info.NofollowLinksCount++
+	}
+
+	if hasToken(rels, "sponsored") {
+		info.SponsoredLinksCount++
+	}
+
+	if hasToken(rels, "ugc") {
+		info.UGCLinksCount++
+	}
+
+	// browsers imply noopener for noreferrer links
+	if anchor.target == "_blank" && !hasToken(rels, "noopener") && !hasToken(rels, "noreferrer") {
+		info.UnsafeBlankLinksCount++
 	}
-	accessChan <- true
 }
 
-func detectHaveLoginForm(query *goquery.Document) bool {
-	html, _ := query.Html()
-	var loginRelatedWords = []string{"login", "password", "signup", "signin", "logout"}
-	for _, word := range loginRelatedWords {
-		if strings.Contains(html, word) {
+func hasToken(tokens []string, token string) bool {
+	for _, t := range tokens {
+		if t == token {
 			return true
 		}
 	}
 	return false
 }
+
+// registrableDomain returns the public suffix plus one label of host, hosts without one like IP addresses
+// or localhost are returned as is
+func registrableDomain(host string) string {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return host
+	}
+
+	return domain
+}
+
+// detectBaseURL returns the URL relative links are resolved against, honoring the <base> element
+func detectBaseURL(query *goquery.Document, pageURL *url.URL) *url.URL {
+	href, ok := query.Find("head base[href]").First().Attr("href")
+	if !ok {
+		return pageURL
+	}
+
+	base, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return pageURL
+	}
+
+	return pageURL.ResolveReference(base)
+}
+
+var (
+	identifierFieldRegEx = regexp.MustCompile(`user|login|email|e-mail|account|phone`)
+	signupFormRegEx      = regexp.MustCompile(`sign[ _-]?up|register|registration|create[ _-]?(an[ _-]?)?account|join`)
+	resetFormRegEx       = regexp.MustCompile(`forgot|reset|recover|lost[ _-]?password`)
+)
+
+// detectForms lists the forms of the page, the kind is inferred from the fields and the wording of the form
+func detectForms(query *goquery.Document, base *url.URL) []internal.Form {
+	forms := []internal.Form{}
+
+	query.Find("form").Each(func(index int, item *goquery.Selection) {
+		form := internal.Form{
+			Action: base.String(),
+			Method: http.MethodGet,
+			Fields: []internal.FormField{},
+		}
+
+		if action, ok := item.Attr("action"); ok && strings.TrimSpace(action) != "" {
+			if ref, err := url.Parse(strings.TrimSpace(action)); err == nil {
+				form.Action = base.ResolveReference(ref).String()
+			}
+		}
+
+		if method, ok := item.Attr("method"); ok && strings.TrimSpace(method) != "" {
+			form.Method = strings.ToUpper(strings.TrimSpace(method))
+		}
+
+		var passwords, identifiers int
+
+		autocomplete := map[string]bool{}
+
+		item.Find("input, select, textarea").Each(func(index int, field *goquery.Selection) {
+			name, _ := field.Attr("name")
+			id, _ := field.Attr("id")
+
+			typ := goquery.NodeName(field)
+			if typ == "input" {
+				typ = strings.ToLower(strings.TrimSpace(field.AttrOr("type", "text")))
+			}
+
+			switch typ {
+			case "submit", "button", "reset", "image":
+				return
+			case "password":
+				passwords++
+			case "email":
+				identifiers++
+			case "text", "tel":
+				if identifierFieldRegEx.MatchString(strings.ToLower(name + " " + id)) {
+					identifiers++
+				}
+			}
+
+			for _, token := range strings.Fields(strings.ToLower(field.AttrOr("autocomplete", ""))) {
+				autocomplete[token] = true
+			}
+
+			_, required := field.Attr("required")
+
+			form.Fields = append(form.Fields, internal.FormField{
+				Name:     name,
+				Type:     typ,
+				Required: required,
+			})
+		})
+
+		form.Kind = detectFormKind(passwords, identifiers, autocomplete, formWording(item, form.Action))
+
+		forms = append(forms, form)
+	})
+
+	return forms
+}
+
+// detectFormKind infers the purpose of a form, autocomplete hints take precedence over the wording
+func detectFormKind(passwords, identifiers int, autocomplete map[string]bool, wording string) internal.FormKind {
+	switch {
+	case autocomplete["new-password"] && !autocomplete["current-password"]:
+		if resetFormRegEx.MatchString(wording) {
+			return internal.FormKindPasswordReset
+		}
+		return internal.FormKindSignup
+	case autocomplete["current-password"]:
+		return internal.FormKindLogin
+	case passwords >= 2:
+		if resetFormRegEx.MatchString(wording) {
+			return internal.FormKindPasswordReset
+		}
+		return internal.FormKindSignup
+	case passwords == 1:
+		if signupFormRegEx.MatchString(wording) {
+			return internal.FormKindSignup
+		}
+		return internal.FormKindLogin
+	case identifiers > 0 && resetFormRegEx.MatchString(wording):
+		return internal.FormKindPasswordReset
+	}
+
+	return internal.FormKindOther
+}
+
+// formWording returns the lowercased text identifying the purpose of the form: its attributes, action,
+// legend, headings and submit buttons
+func formWording(form *goquery.Selection, action string) string {
+	words := []string{
+		form.AttrOr("id", ""),
+		form.AttrOr("name", ""),
+		form.AttrOr("class", ""),
+		form.AttrOr("aria-label", ""),
+		action,
+	}
+
+	form.Find("legend, h1, h2, h3, h4, h5, h6, button, input[type=submit], input[type=image]").Each(func(index int, item *goquery.Selection) {
+		words = append(words, item.Text(), item.AttrOr("value", ""), item.AttrOr("alt", ""))
+	})
+
+	return strings.ToLower(strings.Join(words, " "))
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestDetectHeadings(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><body>
+		<h1>Title</h1>
+		<h2>First
+			section</h2>
+		<div><h3>Nested</h3></div>
+		<h2>Second section</h2>
+		<h6></h6>
+	</body></html>`))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := internal.Headings{
+		H1: 1,
+		H2: 2,
+		H3: 1,
+		H6: 1,
+		Outline: []internal.Heading{
+			{Level: 1, Text: "Title"},
+			{Level: 2, Text: "First section"},
+			{Level: 3, Text: "Nested"},
+			{Level: 2, Text: "Second section"},
+			{Level: 6, Text: ""},
+		},
+	}
+
+	if actual := detectHeadings(doc); !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestCountLink(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><body>
+		<a href="/about">About</a>
+		<a href="https://blog.example.co.uk/post" target="_blank">Blog</a>
+		<a href="https://other.com/" rel="nofollow sponsored" target="_BLANK">Ad</a>
+		<a href="https://other.com/comment" rel="UGC noopener" target="_blank">Comment</a>
+		<a href="https://other.com/share" rel="noreferrer" target="_blank">Share</a>
+		<a href="mailto:info@example.co.uk">Mail</a>
+	</body></html>`))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	page, _ := url.Parse("https://www.example.co.uk/")
+
+	var actual internal.URL
+
+	for _, anchor := range detectAnchors(doc) {
+		target, skip, _ := resolveLink(page, anchor.href)
+		result := LinkResult{Href: anchor.href, URL: target, Skipped: skip, StatusCode: 200}
+
+		countLink(&actual, anchor, result, newLink(page, anchor.text, result))
+	}
+
+	expected := internal.URL{
+		InternalLinksCount:    2,
+		ExternalLinksCount:    3,
+		NofollowLinksCount:    1,
+		SponsoredLinksCount:   1,
+		UGCLinksCount:         1,
+		UnsafeBlankLinksCount: 2,
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestDetectForms(t *testing.T) {
+	t.Parallel()
+
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><body>
+		<a href="/forgot">Forgot password?</a>
+		<form action="/session" method="post">
+			<input type="text" name="username" required>
+			<input type="password" name="password" required>
+			<button type="submit">Log in</button>
+		</form>
+		<form action="/users" method="POST">
+			<input type="email" name="email">
+			<input type="password" name="password">
+			<input type="password" name="password_confirmation">
+			<input type="submit" value="Create account">
+		</form>
+		<form action="/password/reset" method="post">
+			<input type="email" name="email">
+			<button>Send reset link</button>
+		</form>
+		<form action="search">
+			<input name="q">
+			<select name="lang"></select>
+		</form>
+	</body></html>`))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	base, _ := url.Parse("https://example.com/account/")
+
+	expected := []internal.Form{
+		{
+			Kind:   internal.FormKindLogin,
+			Action: "https://example.com/session",
+			Method: "POST",
+			Fields: []internal.FormField{
+				{Name: "username", Type: "text", Required: true},
+				{Name: "password", Type: "password", Required: true},
+			},
+		},
+		{
+			Kind:   internal.FormKindSignup,
+			Action: "https://example.com/users",
+			Method: "POST",
+			Fields: []internal.FormField{
+				{Name: "email", Type: "email"},
+				{Name: "password", Type: "password"},
+				{Name: "password_confirmation", Type: "password"},
+			},
+		},
+		{
+			Kind:   internal.FormKindPasswordReset,
+			Action: "https://example.com/password/reset",
+			Method: "POST",
+			Fields: []internal.FormField{
+				{Name: "email", Type: "email"},
+			},
+		},
+		{
+			Kind:   internal.FormKindOther,
+			Action: "https://example.com/account/search",
+			Method: "GET",
+			Fields: []internal.FormField{
+				{Name: "q", Type: "text"},
+				{Name: "lang", Type: "select"},
+			},
+		},
+	}
+
+	if actual := detectForms(doc, base); !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestCheckContentType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		withErr     bool
+	}{
+		{"OK: html", "text/html; charset=utf-8", "", false},
+		{"OK: xhtml", "application/xhtml+xml", "", false},
+		{"OK: sniffed", "", "<!DOCTYPE html><html></html>", false},
+		{"ERR: json", "application/json", "{}", true},
+		{"ERR: sniffed", "", "%PDF-1.4", true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := FetchResult{Header: http.Header{}, Body: []byte(tt.body)}
+			if tt.contentType != "" {
+				res.Header.Set("Content-Type", tt.contentType)
+			}
+
+			err := checkContentType(res)
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %v", tt.withErr, err)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeUnsupportedContentType) {
+				t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+			}
+		})
+	}
+}
+
+// unnormalizedURLRepository keeps the records by id in memory, ids sort in insertion order
+type unnormalizedURLRepository struct {
+	URLRepository
+	ids  []string
+	URLs map[string]internal.URL
+}
+
+func (r *unnormalizedURLRepository) ListUnnormalized(_ context.Context, afterID string, limit int) ([]internal.URL, error) {
+	var res []internal.URL
+
+	for _, id := range r.ids {
+		if URL := r.URLs[id]; URL.NormalizedURL == "" && id > afterID && len(res) < limit {
+			res = append(res, URL)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *unnormalizedURLRepository) UpdateNormalizedURL(_ context.Context, id string, normalizedURL string) error {
+	URL := r.URLs[id]
+	URL.NormalizedURL = normalizedURL
+	r.URLs[id] = URL
+
+	return nil
+}
+
+func TestURL_BackfillNormalizedURLs(t *testing.T) {
+	t.Parallel()
+
+	repo := &unnormalizedURLRepository{URLs: make(map[string]internal.URL)}
+
+	add := func(URL internal.URL) {
+		URL.ID = fmt.Sprintf("%04d", len(repo.ids))
+		repo.ids = append(repo.ids, URL.ID)
+		repo.URLs[URL.ID] = URL
+	}
+
+	add(internal.URL{URL: "HTTP://Example.com:80/a/../b?utm_source=x"})
+	add(internal.URL{URL: "://bad"})
+	add(internal.URL{URL: "https://example.com", NormalizedURL: "https://example.com/"})
+
+	// more than one batch
+	for i := 0; i < backfillBatchSize; i++ {
+		add(internal.URL{URL: fmt.Sprintf("https://example.com/%d", i)})
+	}
+
+	svc := NewURL(repo, nil, NewNormalizer(NormalizerConfig{}), nil, nil, 0, nil)
+
+	count, err := svc.BackfillNormalizedURLs(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if count != backfillBatchSize+2 {
+		t.Fatalf("expected %d records updated, actual %d", backfillBatchSize+2, count)
+	}
+
+	for id, expected := range map[string]string{
+		"0000": "http://example.com/b",
+		"0001": "://bad",
+		"0002": "https://example.com/",
+		"0102": "https://example.com/99",
+	} {
+		if actual := repo.URLs[id].NormalizedURL; actual != expected {
+			t.Fatalf("expected %q for %s, actual %q", expected, id, actual)
+		}
+	}
+}
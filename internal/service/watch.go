@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+// WatchRepository defines the datastore handling persisting Watch records
+type WatchRepository interface {
+	Create(ctx context.Context, watch internal.Watch) (internal.Watch, error)
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.Watch, error)
+	List(ctx context.Context) ([]internal.Watch, error)
+	Trigger(ctx context.Context, now, retryAt time.Time, next func(internal.Watch) (time.Time, error)) ([]internal.Watch, error)
+}
+
+// Watch defines the application service in charge of re-analyzing watched URLs on their schedule
+type Watch struct {
+	repo       WatchRepository
+	normalizer *Normalizer
+	logger     *zap.Logger
+}
+
+// NewWatch
+func NewWatch(repo WatchRepository, normalizer *Normalizer, logger *zap.Logger) *Watch {
+	return &Watch{
+		repo:       repo,
+		normalizer: normalizer,
+		logger:     logger,
+	}
+}
+
+// Create stores a new watch, its first run is one schedule away from now
+func (w *Watch) Create(ctx context.Context, URL, spec string) (internal.Watch, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.Create")
+	defer span.End()
+
+	watch := internal.Watch{
+		URL:      URL,
+		Schedule: spec,
+	}
+
+	if err := watch.Validate(); err != nil {
+		return internal.Watch{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "watch.Validate")
+	}
+
+	if _, err := w.normalizer.Normalize(URL); err != nil {
+		return internal.Watch{}, fmt.Errorf("normalizer normalize: %w", err)
+	}
+
+	s, err := parseSchedule(spec)
+	if err != nil {
+		return internal.Watch{}, fmt.Errorf("parseSchedule: %w", err)
+	}
+
+	watch.NextRunAt = s.next(time.Now())
+
+	watch, err = w.repo.Create(ctx, watch)
+	if err != nil {
+		return internal.Watch{}, fmt.Errorf("repo create: %w", err)
+	}
+
+	return watch, nil
+}
+
+// Delete removes an existing watch, the analyses it triggered are kept
+func (w *Watch) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.Delete")
+	defer span.End()
+
+	if err := w.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("repo delete: %w", err)
+	}
+
+	return nil
+}
+
+// Find gets an existing watch from the datastore along with the status of its last run
+func (w *Watch) Find(ctx context.Context, id string) (internal.Watch, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.Find")
+	defer span.End()
+
+	watch, err := w.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Watch{}, fmt.Errorf("repo find: %w", err)
+	}
+
+	return watch, nil
+}
+
+// List returns all the watches along with the status of their last run
+func (w *Watch) List(ctx context.Context) ([]internal.Watch, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.List")
+	defer span.End()
+
+	watches, err := w.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo list: %w", err)
+	}
+
+	return watches, nil
+}
+
+// Run enqueues an analysis job for every due watch each pollInterval, it blocks until ctx is cancelled.
+// Replicas running it concurrently never enqueue the same run twice.
+func (w *Watch) Run(ctx context.Context, pollInterval time.Duration) {
+	for {
+		w.trigger(ctx, pollInterval)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// trigger enqueues the jobs of the due watches and schedules their next run, watches with a schedule that
+// fails to parse are retried after retryDelay
+func (w *Watch) trigger(ctx context.Context, retryDelay time.Duration) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WatchTracer").Start(ctx, "Watch.trigger")
+	defer span.End()
+
+	now := time.Now()
+
+	watches, err := w.repo.Trigger(ctx, now, now.Add(retryDelay), func(watch internal.Watch) (time.Time, error) {
+		s, err := parseSchedule(watch.Schedule)
+		if err != nil {
+			w.logger.Error("Couldn't schedule watch, postponing it", zap.String("id", watch.ID), zap.Error(err))
+
+			return time.Time{}, fmt.Errorf("parseSchedule %s: %w", watch.ID, err)
+		}
+
+		return s.next(now), nil
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			span.RecordError(err)
+			w.logger.Error("Couldn't trigger watches", zap.Error(err))
+		}
+
+		return
+	}
+
+	span.SetAttributes(attribute.Int("watches.triggered", len(watches)))
+
+	for _, watch := range watches {
+		w.logger.Info("Watch triggered",
+			zap.String("id", watch.ID),
+			zap.String("job", watch.LastJobID),
+			zap.Time("next", watch.NextRunAt))
+	}
+}
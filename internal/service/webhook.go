@@ -0,0 +1,414 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+const (
+	defaultWebhookTimeout     = 10 * time.Second
+	defaultWebhookMaxAttempts = 8
+	defaultWebhookBackoffBase = 30 * time.Second
+	defaultWebhookBackoffMax  = time.Hour
+
+	// webhookSecretBytes is the size of the secrets generated for webhooks created without one
+	webhookSecretBytes = 32
+)
+
+// Headers sent along with every delivery, the signature is the hex encoded HMAC-SHA256 of the timestamp,
+// a dot and the body, keyed by the secret of the webhook
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// WebhookRepository defines the datastore handling persisting Webhook and WebhookDelivery records
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook internal.Webhook) (internal.Webhook, error)
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.Webhook, error)
+	List(ctx context.Context) ([]internal.Webhook, error)
+	Enqueue(ctx context.Context, event internal.WebhookEvent, payload []byte) error
+	ClaimDelivery(ctx context.Context, now, leaseUntil time.Time) (internal.WebhookDelivery, internal.Webhook, error)
+	UpdateDelivery(ctx context.Context, delivery internal.WebhookDelivery) error
+	FindDeliveries(ctx context.Context, id string) ([]internal.WebhookDelivery, error)
+}
+
+// WebhookConfig defines how deliveries are attempted, zero values use the defaults
+type WebhookConfig struct {
+	// Timeout is the maximum time spent on each attempt
+	Timeout time.Duration
+	// MaxAttempts is the number of attempts after which a delivery is marked as failed
+	MaxAttempts int
+	// BackoffBase is the delay before the second attempt, it doubles after every failed attempt up to BackoffMax
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+// WebhookPayload is the JSON body posted to webhooks
+type WebhookPayload struct {
+	Event      internal.WebhookEvent `json:"event"`
+	OccurredAt time.Time             `json:"occurredAt"`
+	Data       interface{}           `json:"data"`
+}
+
+// analysisEventData is the data of the events sent once an analysis is stored, Previous is the analysis of
+// the same normalized URL it is compared with
+type analysisEventData struct {
+	ID                     string             `json:"id"`
+	URL                    string             `json:"url"`
+	NormalizedURL          string             `json:"normalizedURL"`
+	StatusCode             int                `json:"statusCode"`
+	PageTitle              string             `json:"pageTitle"`
+	InaccessibleLinksCount int                `json:"inaccessibleLinksCount"`
+	CreatedAt              time.Time          `json:"createdAt"`
+	Previous               *analysisEventData `json:"previous,omitempty"`
+}
+
+func newAnalysisEventData(URL internal.URL) *analysisEventData {
+	return &analysisEventData{
+		ID:                     URL.ID,
+		URL:                    URL.URL,
+		NormalizedURL:          URL.NormalizedURL,
+		StatusCode:             URL.StatusCode,
+		PageTitle:              URL.PageTitle,
+		InaccessibleLinksCount: URL.InaccessibleLinksCount,
+		CreatedAt:              URL.CreatedAt,
+	}
+}
+
+// fetchFailedEventData is the data of the event sent when a page could not be fetched, StatusCode is set
+// when the server responded
+type fetchFailedEventData struct {
+	URL           string `json:"url"`
+	NormalizedURL string `json:"normalizedURL"`
+	StatusCode    int    `json:"statusCode,omitempty"`
+	Error         string `json:"error"`
+}
+
+// Webhook defines the application service in charge of managing webhooks and delivering their events
+type Webhook struct {
+	repo        WebhookRepository
+	client      *http.Client
+	timeout     time.Duration
+	maxAttempts int
+	backoffBase time.Duration
+	backoffMax  time.Duration
+	logger      *zap.Logger
+}
+
+// NewWebhook
+func NewWebhook(repo WebhookRepository, client *http.Client, config WebhookConfig, logger *zap.Logger) *Webhook {
+	webhook := &Webhook{
+		repo:        repo,
+		client:      client,
+		timeout:     config.Timeout,
+		maxAttempts: config.MaxAttempts,
+		backoffBase: config.BackoffBase,
+		backoffMax:  config.BackoffMax,
+		logger:      logger,
+	}
+
+	if webhook.timeout <= 0 {
+		webhook.timeout = defaultWebhookTimeout
+	}
+
+	if webhook.maxAttempts <= 0 {
+		webhook.maxAttempts = defaultWebhookMaxAttempts
+	}
+
+	if webhook.backoffBase <= 0 {
+		webhook.backoffBase = defaultWebhookBackoffBase
+	}
+
+	if webhook.backoffMax <= 0 {
+		webhook.backoffMax = defaultWebhookBackoffMax
+	}
+
+	return webhook
+}
+
+// Create stores a new webhook, a random secret is generated when none is given
+func (w *Webhook) Create(ctx context.Context, webhook internal.Webhook) (internal.Webhook, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Create")
+	defer span.End()
+
+	if err := webhook.Validate(); err != nil {
+		return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "webhook.Validate")
+	}
+
+	target, err := url.Parse(webhook.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return internal.Webhook{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "webhook URL must be an absolute http or https URL")
+	}
+
+	if webhook.Secret == "" {
+		secret := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(secret); err != nil {
+			return internal.Webhook{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "rand.Read")
+		}
+
+		webhook.Secret = hex.EncodeToString(secret)
+	}
+
+	webhook, err = w.repo.Create(ctx, webhook)
+	if err != nil {
+		return internal.Webhook{}, fmt.Errorf("repo create: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// Delete removes an existing webhook along with its deliveries
+func (w *Webhook) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Delete")
+	defer span.End()
+
+	if err := w.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("repo delete: %w", err)
+	}
+
+	return nil
+}
+
+// Find gets an existing webhook from the datastore
+func (w *Webhook) Find(ctx context.Context, id string) (internal.Webhook, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Find")
+	defer span.End()
+
+	webhook, err := w.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Webhook{}, fmt.Errorf("repo find: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// List returns all the webhooks
+func (w *Webhook) List(ctx context.Context) ([]internal.Webhook, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.List")
+	defer span.End()
+
+	webhooks, err := w.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo list: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// Deliveries returns the latest deliveries of an existing webhook, newest first
+func (w *Webhook) Deliveries(ctx context.Context, id string) ([]internal.WebhookDelivery, error) {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Deliveries")
+	defer span.End()
+
+	if _, err := w.repo.Find(ctx, id); err != nil {
+		return nil, fmt.Errorf("repo find: %w", err)
+	}
+
+	deliveries, err := w.repo.FindDeliveries(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("repo find deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// Notify enqueues a delivery of the event to every webhook subscribed to it, data is sent as the "data"
+// field of the payload
+func (w *Webhook) Notify(ctx context.Context, event internal.WebhookEvent, data interface{}) error {
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.Notify")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("webhook.event", string(event)))
+
+	payload, err := json.Marshal(WebhookPayload{
+		Event:      event,
+		OccurredAt: time.Now().UTC(),
+		Data:       data,
+	})
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	if err := w.repo.Enqueue(ctx, event, payload); err != nil {
+		return fmt.Errorf("repo enqueue: %w", err)
+	}
+
+	return nil
+}
+
+// Run starts the worker pool delivering pending events, it blocks until ctx is cancelled and all workers
+// finished their current delivery.
+func (w *Webhook) Run(ctx context.Context, workers int, pollInterval time.Duration) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				if w.process(ctx) {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(pollInterval):
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// process claims and attempts one delivery, it returns false when there was nothing to do
+func (w *Webhook) process(ctx context.Context) bool {
+	now := time.Now()
+
+	// the delivery is claimed again by another worker if this one dies in the middle of the attempt
+	delivery, webhook, err := w.repo.ClaimDelivery(ctx, now, now.Add(2*w.timeout))
+	if err != nil {
+		var ierr *internal.Error
+		if ctx.Err() == nil && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound) {
+			w.logger.Error("Couldn't claim webhook delivery", zap.Error(err))
+		}
+
+		return false
+	}
+
+	ctx, span := trace.SpanFromContext(ctx).TracerProvider().Tracer("WebhookTracer").Start(ctx, "Webhook.process")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("webhook.id", webhook.ID),
+		attribute.String("webhook.event", string(delivery.Event)),
+		attribute.Int("webhook.attempt", delivery.Attempts))
+
+	delivery = w.attempt(ctx, webhook, delivery)
+
+	if delivery.Status != internal.WebhookDeliveryStatusSucceeded {
+		span.RecordError(errors.New(delivery.Error))
+	}
+
+	if err := w.repo.UpdateDelivery(ctx, delivery); err != nil {
+		w.logger.Error("Couldn't update webhook delivery", zap.String("id", delivery.ID), zap.Error(err))
+	}
+
+	return true
+}
+
+// attempt posts the delivery to the webhook and returns it updated with the outcome: succeeded on a 2xx
+// response, pending with its next attempt backed off, or failed once the attempts are exhausted
+func (w *Webhook) attempt(ctx context.Context, webhook internal.Webhook, delivery internal.WebhookDelivery) internal.WebhookDelivery {
+	status, err := w.post(ctx, webhook, delivery)
+
+	delivery.ResponseStatus = status
+	delivery.Error = ""
+
+	if err == nil {
+		delivery.Status = internal.WebhookDeliveryStatusSucceeded
+		return delivery
+	}
+
+	delivery.Error = err.Error()
+
+	if delivery.Attempts >= w.maxAttempts {
+		delivery.Status = internal.WebhookDeliveryStatusFailed
+		return delivery
+	}
+
+	delivery.Status = internal.WebhookDeliveryStatusPending
+	delivery.NextAttemptAt = time.Now().Add(w.backoff(delivery.Attempts))
+
+	return delivery
+}
+
+// backoff returns the delay before the attempt following the given one
+func (w *Webhook) backoff(attempts int) time.Duration {
+	delay := w.backoffBase
+
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+
+		if delay >= w.backoffMax {
+			return w.backoffMax
+		}
+	}
+
+	if delay > w.backoffMax {
+		return w.backoffMax
+	}
+
+	return delay
+}
+
+// post sends the signed payload, responses other than 2xx are errors
+func (w *Webhook) post(ctx context.Context, webhook internal.Webhook, delivery internal.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("new request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, string(delivery.Event))
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID)
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(webhook.Secret, timestamp, delivery.Payload))
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("post: %w", err)
+	}
+	defer res.Body.Close()
+
+	// drain a bit of the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4<<10))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+// SignWebhook returns the value of the signature header of a delivery, receivers compute it again from the
+// timestamp header and the raw body and compare both with hmac.Equal
+func SignWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestWebhook_Attempt(t *testing.T) {
+	t.Parallel()
+
+	const secret = "s3cr3t"
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		expected := SignWebhook(secret, r.Header.Get(WebhookTimestampHeader), body)
+		if !hmac.Equal([]byte(expected), []byte(r.Header.Get(WebhookSignatureHeader))) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.Header.Get(WebhookEventHeader) != string(internal.WebhookEventAnalysisCompleted) ||
+			r.Header.Get(WebhookDeliveryHeader) != "a-b-c" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusNoContent)
+		case "/unavailable":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	webhooks := NewWebhook(nil, srv.Client(), WebhookConfig{
+		Timeout:     100 * time.Millisecond,
+		MaxAttempts: 3,
+		BackoffBase: time.Minute,
+		BackoffMax:  time.Hour,
+	}, zap.NewNop())
+
+	tests := []struct {
+		name           string
+		path           string
+		secret         string
+		attempts       int
+		expectedStatus internal.WebhookDeliveryStatus
+		expectedCode   int
+		expectedDelay  time.Duration
+	}{
+		{
+			"OK: succeeded",
+			"/ok",
+			secret,
+			1,
+			internal.WebhookDeliveryStatusSucceeded,
+			http.StatusNoContent,
+			0,
+		},
+		{
+			"ERR: retried",
+			"/unavailable",
+			secret,
+			2,
+			internal.WebhookDeliveryStatusPending,
+			http.StatusServiceUnavailable,
+			2 * time.Minute,
+		},
+		{
+			"ERR: signature",
+			"/ok",
+			"other",
+			1,
+			internal.WebhookDeliveryStatusPending,
+			http.StatusUnauthorized,
+			time.Minute,
+		},
+		{
+			"ERR: timeout",
+			"/slow",
+			secret,
+			1,
+			internal.WebhookDeliveryStatusPending,
+			0,
+			time.Minute,
+		},
+		{
+			"ERR: attempts exhausted",
+			"/unavailable",
+			secret,
+			3,
+			internal.WebhookDeliveryStatusFailed,
+			http.StatusServiceUnavailable,
+			0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Now()
+
+			actual := webhooks.attempt(context.Background(),
+				internal.Webhook{
+					URL:    srv.URL + tt.path,
+					Secret: tt.secret,
+				},
+				internal.WebhookDelivery{
+					ID:       "a-b-c",
+					Event:    internal.WebhookEventAnalysisCompleted,
+					Payload:  []byte(`{"event":"analysis.completed"}`),
+					Attempts: tt.attempts,
+				})
+
+			if actual.Status != tt.expectedStatus {
+				t.Fatalf("expected status %s, got %s (%s)", tt.expectedStatus, actual.Status, actual.Error)
+			}
+
+			if actual.ResponseStatus != tt.expectedCode {
+				t.Fatalf("expected response status %d, got %d", tt.expectedCode, actual.ResponseStatus)
+			}
+
+			if (actual.Error == "") != (tt.expectedStatus == internal.WebhookDeliveryStatusSucceeded) {
+				t.Fatalf("unexpected error %q", actual.Error)
+			}
+
+			if tt.expectedDelay > 0 {
+				if delay := actual.NextAttemptAt.Sub(start); delay < tt.expectedDelay || delay > tt.expectedDelay+time.Second {
+					t.Fatalf("expected next attempt in %s, got %s", tt.expectedDelay, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestWebhook_Backoff(t *testing.T) {
+	t.Parallel()
+
+	webhooks := NewWebhook(nil, nil, WebhookConfig{
+		BackoffBase: 30 * time.Second,
+		BackoffMax:  5 * time.Minute,
+	}, zap.NewNop())
+
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{4, 4 * time.Minute},
+		{5, 5 * time.Minute},
+		{60, 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if actual := webhooks.backoff(tt.attempts); actual != tt.expected {
+			t.Fatalf("attempts %d: expected %s, got %s", tt.attempts, tt.expected, actual)
+		}
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	t.Parallel()
+
+	// echo -n '1625220000.{}' | openssl dgst -sha256 -hmac s3cr3t
+	expected := "sha256=205782679402e5bfddd5299417f4cd778e0f6cb2d71e7f9d1550fbed1384c26b"
+
+	if actual := SignWebhook("s3cr3t", "1625220000", []byte("{}")); actual != expected {
+		t.Fatalf("expected %s, got %s", expected, actual)
+	}
+}
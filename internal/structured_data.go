@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"encoding/json"
+)
+
+// StructuredDataFormat is how a structured data item is embedded in a page
+type StructuredDataFormat string
+
+const (
+	StructuredDataFormatJSONLD    StructuredDataFormat = "jsonld"
+	StructuredDataFormatMicrodata StructuredDataFormat = "microdata"
+)
+
+// StructuredData is what a page tells about itself to social networks and search engines, it's stored as
+// the structuredData section of the analysis
+type StructuredData struct {
+	// OpenGraph and TwitterCard map the og: and twitter: properties to their first value
+	OpenGraph   map[string]string `json:"openGraph"`
+	TwitterCard map[string]string `json:"twitterCard"`
+	// OpenGraphMissing and TwitterCardMissing list the properties required for rendering a shared page,
+	// twitter: properties falling back to Open Graph are only missing when both are
+	OpenGraphMissing   []string             `json:"openGraphMissing"`
+	TwitterCardMissing []string             `json:"twitterCardMissing"`
+	JSONLD             []JSONLDBlock        `json:"jsonld"`
+	Items              []StructuredDataItem `json:"items"`
+}
+
+// JSONLDBlock is the content of a JSON-LD script, Error is set instead of Data when it's not valid JSON
+type JSONLDBlock struct {
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// StructuredDataItem is a top level item of the JSON-LD blocks or the microdata of a page
+type StructuredDataItem struct {
+	Format StructuredDataFormat `json:"format"`
+	// Types are the schema.org types without vocabulary, other vocabularies are kept as is
+	Types []string `json:"types"`
+	// MissingFields lists the required properties of the schema.org types the item lacks
+	MissingFields []string `json:"missingFields"`
+}
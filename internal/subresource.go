@@ -0,0 +1,41 @@
+package internal
+
+// SubresourceType is the kind of resource a page loads
+type SubresourceType string
+
+const (
+	SubresourceTypeScript     SubresourceType = "script"
+	SubresourceTypeStylesheet SubresourceType = "stylesheet"
+	SubresourceTypeImage      SubresourceType = "image"
+	SubresourceTypeIframe     SubresourceType = "iframe"
+	SubresourceTypeFont       SubresourceType = "font"
+	SubresourceTypeMedia      SubresourceType = "media"
+)
+
+// Subresources is the inventory of what a page loads, it's stored as the subresources section of the analysis
+type Subresources struct {
+	Items                 []Subresource `json:"items"`
+	ThirdPartyCount       int           `json:"thirdPartyCount"`
+	MixedContentCount     int           `json:"mixedContentCount"`
+	MissingIntegrityCount int           `json:"missingIntegrityCount"`
+	// Checked is set when the availability of the items was checked
+	Checked bool `json:"checked"`
+}
+
+// Subresource is a resource referenced by a page, each URL is listed once per type
+type Subresource struct {
+	Type SubresourceType `json:"type"`
+	// URL is resolved against the page
+	URL string `json:"url"`
+	// ThirdParty is set when the registrable domain differs from the one of the page
+	ThirdParty bool `json:"thirdParty"`
+	// MixedContent is set for http:// resources of https:// pages
+	MixedContent bool `json:"mixedContent"`
+	// MissingIntegrity is set for third party scripts without Subresource Integrity
+	MissingIntegrity bool   `json:"missingIntegrity"`
+	Integrity        string `json:"integrity,omitempty"`
+	// StatusClass, StatusCode and Error are only set when checked, as done for links
+	StatusClass LinkStatusClass `json:"statusClass,omitempty"`
+	StatusCode  int             `json:"statusCode,omitempty"`
+	Error       string          `json:"error,omitempty"`
+}
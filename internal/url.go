@@ -1,15 +1,45 @@
 // Package internal defines the types used to create URL and their corresponding attributes
 package internal
 
+import (
+	"encoding/json"
+	"time"
+)
+
 // URL is an activity that needs to be completed within a period of time
 type URL struct {
-	ID                     string
+	ID  string
+	URL string
+	// NormalizedURL identifies the page, analyses of URLs normalizing to the same value are grouped together
+	NormalizedURL          string
+	FinalURL               string
+	Redirects              []Redirect
+	StatusCode             int
+	ContentType            string
+	ContentLength          int64
+	FetchDuration          time.Duration
 	HTMLVersion            string
+	Doctype                Doctype
 	PageTitle              string
-	HeadingsCount          string
+	Headings               Headings
 	LinksCount             int
 	InaccessibleLinksCount int
-	HaveLoginForm          bool
+	// InternalLinksCount and ExternalLinksCount split the links by registrable domain of the page
+	InternalLinksCount int
+	ExternalLinksCount int
+	// NofollowLinksCount, SponsoredLinksCount and UGCLinksCount count the links by rel qualifier
+	NofollowLinksCount  int
+	SponsoredLinksCount int
+	UGCLinksCount       int
+	// UnsafeBlankLinksCount counts the links opening in a new tab without rel=noopener
+	UnsafeBlankLinksCount int
+	// HaveLoginForm is set when one of the Forms is a login form
+	HaveLoginForm bool
+	Forms         []Form
+	// Sections holds the findings of the analyzers without a dedicated field, keyed by analyzer name
+	Sections map[string]json.RawMessage
+	// CreatedAt is when the page was fetched, the current time is stored when it's zero
+	CreatedAt time.Time
 }
 
 // Validate ...
@@ -20,8 +50,97 @@ func (u URL) Validate() error {
 	if u.PageTitle == "" {
 		return NewErrorf(ErrorCodeInvalidArgument, "PageTitle is required")
 	}
-	if u.HeadingsCount == "" {
-		return NewErrorf(ErrorCodeInvalidArgument, "HeadingsCount is required")
+	return nil
+}
+
+// Headings counts the heading elements of a page per level, Outline lists them in document order
+type Headings struct {
+	H1      int       `json:"h1"`
+	H2      int       `json:"h2"`
+	H3      int       `json:"h3"`
+	H4      int       `json:"h4"`
+	H5      int       `json:"h5"`
+	H6      int       `json:"h6"`
+	Outline []Heading `json:"outline"`
+}
+
+// Heading is one heading element of a page
+type Heading struct {
+	Level int    `json:"level"`
+	Text  string `json:"text"`
+}
+
+// URLSort defines the supported orders for listing URL records, newest or most broken first
+type URLSort string
+
+const (
+	URLSortCreatedAt              URLSort = "createdAt"
+	URLSortInaccessibleLinksCount URLSort = "inaccessibleLinksCount"
+)
+
+// MaxListURLsLimit is the maximum number of records returned in one page
+const MaxListURLsLimit = 100
+
+// ListURLsParams defines the arguments used for filtering, sorting and paginating URL records
+type ListURLsParams struct {
+	Host          string
+	HTMLVersion   string
+	HaveLoginForm *bool
+	CreatedFrom   time.Time
+	CreatedTo     time.Time
+	Sort          URLSort
+	Cursor        string
+	Limit         int
+}
+
+// Validate ...
+func (p ListURLsParams) Validate() error {
+	switch p.Sort {
+	case URLSortCreatedAt, URLSortInaccessibleLinksCount:
+	default:
+		return NewErrorf(ErrorCodeInvalidArgument, "unsupported sort %q", p.Sort)
+	}
+	if p.Limit < 1 || p.Limit > MaxListURLsLimit {
+		return NewErrorf(ErrorCodeInvalidArgument, "limit must be between 1 and %d", MaxListURLsLimit)
+	}
+	if !p.CreatedFrom.IsZero() && !p.CreatedTo.IsZero() && !p.CreatedFrom.Before(p.CreatedTo) {
+		return NewErrorf(ErrorCodeInvalidArgument, "createdFrom must be before createdTo")
 	}
 	return nil
 }
+
+// ListURLsResult defines one page of URL records, NextCursor is empty on the last page
+type ListURLsResult struct {
+	URLs       []URL
+	NextCursor string
+}
+
+// MaxSearchMaxAge is the oldest stored analysis a search may ask to reuse
+const MaxSearchMaxAge = 365 * 24 * time.Hour
+
+// SearchParams defines the arguments used for analyzing a URL, a stored analysis younger than MaxAge is
+// reused when set, otherwise the server default applies
+type SearchParams struct {
+	URL    string
+	MaxAge *time.Duration
+}
+
+// Validate ...
+func (p SearchParams) Validate() error {
+	if p.URL == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "URL is required")
+	}
+	if p.MaxAge != nil && *p.MaxAge < 0 {
+		return NewErrorf(ErrorCodeInvalidArgument, "maxAge must not be negative")
+	}
+	if p.MaxAge != nil && *p.MaxAge > MaxSearchMaxAge {
+		return NewErrorf(ErrorCodeInvalidArgument, "maxAge must not exceed %d seconds", int64(MaxSearchMaxAge/time.Second))
+	}
+	return nil
+}
+
+// SearchResult defines the analysis of a URL, Cached is set when a stored analysis was reused
+type SearchResult struct {
+	URL    URL
+	Cached bool
+}
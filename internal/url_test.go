@@ -3,6 +3,7 @@ package internal_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Oguzyildirim/url-info/internal"
 )
@@ -20,7 +21,7 @@ func TestURL_Validate(t *testing.T) {
 			internal.URL{
 				HTMLVersion:            "22",
 				PageTitle:              "title",
-				HeadingsCount:          "hc",
+				Headings:               internal.Headings{H1: 1},
 				LinksCount:             2,
 				InaccessibleLinksCount: 5,
 				HaveLoginForm:          true,
@@ -31,7 +32,7 @@ func TestURL_Validate(t *testing.T) {
 			"ERR: HTMLVersion",
 			internal.URL{
 				PageTitle:              "title",
-				HeadingsCount:          "hc",
+				Headings:               internal.Headings{H1: 1},
 				LinksCount:             2,
 				InaccessibleLinksCount: 5,
 				HaveLoginForm:          true,
@@ -42,21 +43,137 @@ func TestURL_Validate(t *testing.T) {
 			"ERR: PageTitle",
 			internal.URL{
 				HTMLVersion:            "22",
-				HeadingsCount:          "hc",
+				Headings:               internal.Headings{H1: 1},
 				LinksCount:             2,
 				InaccessibleLinksCount: 5,
 				HaveLoginForm:          true,
 			},
 			true,
 		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestListURLsParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.ListURLsParams
+		withErr bool
+	}{
 		{
-			"ERR: HeadingsCount",
-			internal.URL{
-				HTMLVersion:            "22",
-				PageTitle:              "title",
-				LinksCount:             2,
-				InaccessibleLinksCount: 5,
-				HaveLoginForm:          true,
+			"OK",
+			internal.ListURLsParams{
+				Sort:        internal.URLSortInaccessibleLinksCount,
+				Limit:       10,
+				CreatedFrom: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+				CreatedTo:   time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC),
+			},
+			false,
+		},
+		{
+			"ERR: Sort",
+			internal.ListURLsParams{
+				Sort:  "pageTitle",
+				Limit: 10,
+			},
+			true,
+		},
+		{
+			"ERR: Limit",
+			internal.ListURLsParams{
+				Sort:  internal.URLSortCreatedAt,
+				Limit: internal.MaxListURLsLimit + 1,
+			},
+			true,
+		},
+		{
+			"ERR: CreatedFrom",
+			internal.ListURLsParams{
+				Sort:        internal.URLSortCreatedAt,
+				Limit:       10,
+				CreatedFrom: time.Date(2021, 7, 2, 0, 0, 0, 0, time.UTC),
+				CreatedTo:   time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC),
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestSearchParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	maxAge := time.Hour
+	negative := -time.Second
+	tooLong := internal.MaxSearchMaxAge + time.Second
+
+	tests := []struct {
+		name    string
+		input   internal.SearchParams
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.SearchParams{
+				URL:    "https://example.com",
+				MaxAge: &maxAge,
+			},
+			false,
+		},
+		{
+			"ERR: URL",
+			internal.SearchParams{},
+			true,
+		},
+		{
+			"ERR: MaxAge",
+			internal.SearchParams{
+				URL:    "https://example.com",
+				MaxAge: &negative,
+			},
+			true,
+		},
+		{
+			"ERR: MaxAge too long",
+			internal.SearchParams{
+				URL:    "https://example.com",
+				MaxAge: &tooLong,
 			},
 			true,
 		},
@@ -0,0 +1,33 @@
+package internal
+
+import (
+	"time"
+)
+
+// Watch re-analyzes a URL on a schedule, the Last fields describe the job enqueued by the latest run and
+// are empty until the first one
+type Watch struct {
+	ID  string
+	URL string
+	// Schedule is either an interval like "1h" or "@every 30m", or a cron expression evaluated in UTC
+	Schedule   string
+	NextRunAt  time.Time
+	LastRunAt  time.Time
+	LastJobID  string
+	LastStatus JobStatus
+	LastURLID  string
+	LastError  string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Validate ...
+func (w Watch) Validate() error {
+	if w.URL == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "URL is required")
+	}
+	if w.Schedule == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "schedule is required")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestWatch_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.Watch
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.Watch{
+				URL:      "https://example.com",
+				Schedule: "@every 1h",
+			},
+			false,
+		},
+		{
+			"ERR: URL",
+			internal.Watch{
+				Schedule: "@every 1h",
+			},
+			true,
+		},
+		{
+			"ERR: Schedule",
+			internal.Watch{
+				URL: "https://example.com",
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"time"
+)
+
+// WebhookEvent is a notification webhooks can subscribe to
+type WebhookEvent string
+
+const (
+	// WebhookEventAnalysisCompleted is sent once an analysis is stored
+	WebhookEventAnalysisCompleted WebhookEvent = "analysis.completed"
+	// WebhookEventLinksBrokenIncreased is sent when an analysis has more inaccessible links than the previous one
+	WebhookEventLinksBrokenIncreased WebhookEvent = "links.broken_increased"
+	// WebhookEventTitleChanged is sent when the title differs from the one of the previous analysis
+	WebhookEventTitleChanged WebhookEvent = "title.changed"
+	// WebhookEventFetchFailed is sent when the page could not be fetched
+	WebhookEventFetchFailed WebhookEvent = "fetch.failed"
+)
+
+// Validate ...
+func (e WebhookEvent) Validate() error {
+	switch e {
+	case WebhookEventAnalysisCompleted, WebhookEventLinksBrokenIncreased, WebhookEventTitleChanged, WebhookEventFetchFailed:
+		return nil
+	}
+	return NewErrorf(ErrorCodeInvalidArgument, "unsupported event %q", e)
+}
+
+// Webhook is a subscription to events, deliveries are posted to URL and signed with Secret
+type Webhook struct {
+	ID        string
+	URL       string
+	Events    []WebhookEvent
+	Secret    string
+	CreatedAt time.Time
+}
+
+// Validate ...
+func (w Webhook) Validate() error {
+	if w.URL == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "URL is required")
+	}
+	if len(w.Events) == 0 {
+		return NewErrorf(ErrorCodeInvalidArgument, "events are required")
+	}
+	for _, event := range w.Events {
+		if err := event.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WebhookDeliveryStatus defines the states a delivery goes through
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is one event sent to a webhook, pending deliveries are attempted again at NextAttemptAt
+type WebhookDelivery struct {
+	ID            string
+	WebhookID     string
+	Event         WebhookEvent
+	Payload       []byte
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	// ResponseStatus and Error describe the outcome of the latest attempt
+	ResponseStatus int
+	Error          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
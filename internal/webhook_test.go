@@ -0,0 +1,67 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Oguzyildirim/url-info/internal"
+)
+
+func TestWebhook_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.Webhook
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.Webhook{
+				URL:    "https://hooks.example.com",
+				Events: []internal.WebhookEvent{internal.WebhookEventAnalysisCompleted, internal.WebhookEventFetchFailed},
+			},
+			false,
+		},
+		{
+			"ERR: URL",
+			internal.Webhook{
+				Events: []internal.WebhookEvent{internal.WebhookEventAnalysisCompleted},
+			},
+			true,
+		},
+		{
+			"ERR: Events",
+			internal.Webhook{
+				URL: "https://hooks.example.com",
+			},
+			true,
+		},
+		{
+			"ERR: Events unsupported",
+			internal.Webhook{
+				URL:    "https://hooks.example.com",
+				Events: []internal.WebhookEvent{"page.deleted"},
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
@@ -3,20 +3,643 @@
 // Code generated by github.com/deepmap/oapi-codegen version v1.8.1 DO NOT EDIT.
 package openapi3
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Defines values for AccessibilityIssueRule.
+const (
+	AccessibilityIssueRuleButtonName AccessibilityIssueRule = "button-name"
+
+	AccessibilityIssueRuleDuplicateId AccessibilityIssueRule = "duplicate-id"
+
+	AccessibilityIssueRuleHeadingOrder AccessibilityIssueRule = "heading-order"
+
+	AccessibilityIssueRuleHtmlLang AccessibilityIssueRule = "html-lang"
+
+	AccessibilityIssueRuleImageAlt AccessibilityIssueRule = "image-alt"
+
+	AccessibilityIssueRuleInputLabel AccessibilityIssueRule = "input-label"
+
+	AccessibilityIssueRuleLinkName AccessibilityIssueRule = "link-name"
+
+	AccessibilityIssueRuleTableHeaders AccessibilityIssueRule = "table-headers"
+)
+
+// Defines values for AccessibilityIssueSeverity.
+const (
+	AccessibilityIssueSeverityCritical AccessibilityIssueSeverity = "critical"
+
+	AccessibilityIssueSeverityMinor AccessibilityIssueSeverity = "minor"
+
+	AccessibilityIssueSeverityModerate AccessibilityIssueSeverity = "moderate"
+
+	AccessibilityIssueSeveritySerious AccessibilityIssueSeverity = "serious"
+)
+
+// Defines values for CrawlScope.
+const (
+	CrawlScopeHost CrawlScope = "host"
+
+	CrawlScopePathPrefix CrawlScope = "pathPrefix"
+)
+
+// Defines values for CrawlStatus.
+const (
+	CrawlStatusFailed CrawlStatus = "failed"
+
+	CrawlStatusQueued CrawlStatus = "queued"
+
+	CrawlStatusRunning CrawlStatus = "running"
+
+	CrawlStatusSucceeded CrawlStatus = "succeeded"
+)
+
+// Defines values for DoctypeMode.
+const (
+	DoctypeModeLimitedQuirks DoctypeMode = "limitedQuirks"
+
+	DoctypeModeNoQuirks DoctypeMode = "noQuirks"
+
+	DoctypeModeQuirks DoctypeMode = "quirks"
+)
+
+// Defines values for FormKind.
+const (
+	FormKindLogin FormKind = "login"
+
+	FormKindOther FormKind = "other"
+
+	FormKindPasswordReset FormKind = "passwordReset"
+
+	FormKindSignup FormKind = "signup"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusFailed JobStatus = "failed"
+
+	JobStatusQueued JobStatus = "queued"
+
+	JobStatusRunning JobStatus = "running"
+
+	JobStatusSucceeded JobStatus = "succeeded"
+)
+
+// Defines values for LinkStatusClass.
+const (
+	LinkStatusClassError LinkStatusClass = "error"
+
+	LinkStatusClassN2xx LinkStatusClass = "2xx"
+
+	LinkStatusClassN3xx LinkStatusClass = "3xx"
+
+	LinkStatusClassN4xx LinkStatusClass = "4xx"
+
+	LinkStatusClassN5xx LinkStatusClass = "5xx"
+
+	LinkStatusClassSkipped LinkStatusClass = "skipped"
+)
+
+// Defines values for MetadataWarnings.
+const (
+	MetadataWarningsDescriptionDuplicate MetadataWarnings = "description.duplicate"
+
+	MetadataWarningsDescriptionMissing MetadataWarnings = "description.missing"
+
+	MetadataWarningsTitleDuplicate MetadataWarnings = "title.duplicate"
+
+	MetadataWarningsTitleMissing MetadataWarnings = "title.missing"
+)
+
+// Defines values for ProblemType.
+const (
+	ProblemTypeProblemsconflict ProblemType = "/problems/conflict"
+
+	ProblemTypeProblemsdisallowedByRobots ProblemType = "/problems/disallowed-by-robots"
+
+	ProblemTypeProblemsforbiddenTarget ProblemType = "/problems/forbidden-target"
+
+	ProblemTypeProblemsinternal ProblemType = "/problems/internal"
+
+	ProblemTypeProblemsinvalidArgument ProblemType = "/problems/invalid-argument"
+
+	ProblemTypeProblemsnotFound ProblemType = "/problems/not-found"
+
+	ProblemTypeProblemsrateLimited ProblemType = "/problems/rate-limited"
+
+	ProblemTypeProblemstimeout ProblemType = "/problems/timeout"
+
+	ProblemTypeProblemstooLarge ProblemType = "/problems/too-large"
+
+	ProblemTypeProblemsunauthorized ProblemType = "/problems/unauthorized"
+
+	ProblemTypeProblemsunsupportedContentType ProblemType = "/problems/unsupported-content-type"
+
+	ProblemTypeProblemsupstreamStatus ProblemType = "/problems/upstream-status"
+
+	ProblemTypeProblemsupstreamUnreachable ProblemType = "/problems/upstream-unreachable"
+)
+
+// Defines values for SecurityGrade.
+const (
+	SecurityGradeA SecurityGrade = "A"
+
+	SecurityGradeB SecurityGrade = "B"
+
+	SecurityGradeC SecurityGrade = "C"
+
+	SecurityGradeD SecurityGrade = "D"
+
+	SecurityGradeF SecurityGrade = "F"
+)
+
+// Defines values for SecurityWarnings.
+const (
+	SecurityWarningsCookieInsecure SecurityWarnings = "cookie.insecure"
+
+	SecurityWarningsCookieNoHttponly SecurityWarnings = "cookie.no-httponly"
+
+	SecurityWarningsCookieNoSamesite SecurityWarnings = "cookie.no-samesite"
+
+	SecurityWarningsCspMissing SecurityWarnings = "csp.missing"
+
+	SecurityWarningsCspUnsafeEval SecurityWarnings = "csp.unsafe-eval"
+
+	SecurityWarningsCspUnsafeInline SecurityWarnings = "csp.unsafe-inline"
+
+	SecurityWarningsHstsMissing SecurityWarnings = "hsts.missing"
+
+	SecurityWarningsHstsShortMaxAge SecurityWarnings = "hsts.short-max-age"
+
+	SecurityWarningsPermissionsPolicyMissing SecurityWarnings = "permissions-policy.missing"
+
+	SecurityWarningsReferrerPolicyMissing SecurityWarnings = "referrer-policy.missing"
+
+	SecurityWarningsTlsExpiring SecurityWarnings = "tls.expiring"
+
+	SecurityWarningsTlsMissing SecurityWarnings = "tls.missing"
+
+	SecurityWarningsXContentTypeOptionsMissing SecurityWarnings = "x-content-type-options.missing"
+
+	SecurityWarningsXFrameOptionsMissing SecurityWarnings = "x-frame-options.missing"
+)
+
+// Defines values for StructuredDataItemFormat.
+const (
+	StructuredDataItemFormatJsonld StructuredDataItemFormat = "jsonld"
+
+	StructuredDataItemFormatMicrodata StructuredDataItemFormat = "microdata"
+)
+
+// Defines values for SubresourceStatusClass.
+const (
+	SubresourceStatusClassError SubresourceStatusClass = "error"
+
+	SubresourceStatusClassN2xx SubresourceStatusClass = "2xx"
+
+	SubresourceStatusClassN3xx SubresourceStatusClass = "3xx"
+
+	SubresourceStatusClassN4xx SubresourceStatusClass = "4xx"
+
+	SubresourceStatusClassN5xx SubresourceStatusClass = "5xx"
+
+	SubresourceStatusClassSkipped SubresourceStatusClass = "skipped"
+)
+
+// Defines values for SubresourceType.
+const (
+	SubresourceTypeFont SubresourceType = "font"
+
+	SubresourceTypeIframe SubresourceType = "iframe"
+
+	SubresourceTypeImage SubresourceType = "image"
+
+	SubresourceTypeMedia SubresourceType = "media"
+
+	SubresourceTypeScript SubresourceType = "script"
+
+	SubresourceTypeStylesheet SubresourceType = "stylesheet"
+)
+
+// Defines values for WatchLastStatus.
+const (
+	WatchLastStatusFailed WatchLastStatus = "failed"
+
+	WatchLastStatusQueued WatchLastStatus = "queued"
+
+	WatchLastStatusRunning WatchLastStatus = "running"
+
+	WatchLastStatusSucceeded WatchLastStatus = "succeeded"
+)
+
+// Defines values for WebhookEvents.
+const (
+	WebhookEventsAnalysisCompleted WebhookEvents = "analysis.completed"
+
+	WebhookEventsFetchFailed WebhookEvents = "fetch.failed"
+
+	WebhookEventsLinksBrokenIncreased WebhookEvents = "links.broken_increased"
+
+	WebhookEventsTitleChanged WebhookEvents = "title.changed"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// AccessibilityIssue defines model for AccessibilityIssue.
+type AccessibilityIssue struct {
+	Location *string                     `json:"location,omitempty"`
+	Message  *string                     `json:"message,omitempty"`
+	Rule     *AccessibilityIssueRule     `json:"rule,omitempty"`
+	Severity *AccessibilityIssueSeverity `json:"severity,omitempty"`
+}
+
+// AccessibilityIssueRule defines model for AccessibilityIssue.Rule.
+type AccessibilityIssueRule string
+
+// AccessibilityIssueSeverity defines model for AccessibilityIssue.Severity.
+type AccessibilityIssueSeverity string
+
+// Crawl defines model for Crawl.
+type Crawl struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Error     *string    `json:"error,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	MaxDepth  *int32     `json:"maxDepth,omitempty"`
+	MaxPages  *int32     `json:"maxPages,omitempty"`
+	Pages     *[]struct {
+		URLId                  *string `json:"URLId,omitempty"`
+		Depth                  *int32  `json:"depth,omitempty"`
+		Error                  *string `json:"error,omitempty"`
+		HaveLoginForm          *bool   `json:"haveLoginForm,omitempty"`
+		InaccessibleLinksCount *int32  `json:"inaccessibleLinksCount,omitempty"`
+		PageTitle              *string `json:"pageTitle,omitempty"`
+		Url                    *string `json:"url,omitempty"`
+	} `json:"pages,omitempty"`
+	Scope   *CrawlScope  `json:"scope,omitempty"`
+	Status  *CrawlStatus `json:"status,omitempty"`
+	Summary *struct {
+		BrokenLinksCount        *int32 `json:"brokenLinksCount,omitempty"`
+		PagesCrawled            *int32 `json:"pagesCrawled,omitempty"`
+		PagesFailed             *int32 `json:"pagesFailed,omitempty"`
+		PagesWithLoginFormCount *int32 `json:"pagesWithLoginFormCount,omitempty"`
+		PagesWithoutTitleCount  *int32 `json:"pagesWithoutTitleCount,omitempty"`
+	} `json:"summary,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	Url       *string    `json:"url,omitempty"`
+}
+
+// CrawlScope defines model for Crawl.Scope.
+type CrawlScope string
+
+// CrawlStatus defines model for Crawl.Status.
+type CrawlStatus string
+
+// Doctype defines model for Doctype.
+type Doctype struct {
+	Mode     *DoctypeMode `json:"mode,omitempty"`
+	Name     *string      `json:"name,omitempty"`
+	PublicID *string      `json:"publicID,omitempty"`
+	SystemID *string      `json:"systemID,omitempty"`
+}
+
+// DoctypeMode defines model for Doctype.Mode.
+type DoctypeMode string
+
+// FieldChange defines model for FieldChange.
+type FieldChange struct {
+	Field *string      `json:"field,omitempty"`
+	From  *interface{} `json:"from,omitempty"`
+	To    *interface{} `json:"to,omitempty"`
+}
+
+// Form defines model for Form.
+type Form struct {
+	Action *string `json:"action,omitempty"`
+	Fields *[]struct {
+		Name     *string `json:"name,omitempty"`
+		Required *bool   `json:"required,omitempty"`
+		Type     *string `json:"type,omitempty"`
+	} `json:"fields,omitempty"`
+	Kind   *FormKind `json:"kind,omitempty"`
+	Method *string   `json:"method,omitempty"`
+}
+
+// FormKind defines model for Form.Kind.
+type FormKind string
+
+// Headings defines model for Headings.
+type Headings struct {
+	H1      *int32 `json:"h1,omitempty"`
+	H2      *int32 `json:"h2,omitempty"`
+	H3      *int32 `json:"h3,omitempty"`
+	H4      *int32 `json:"h4,omitempty"`
+	H5      *int32 `json:"h5,omitempty"`
+	H6      *int32 `json:"h6,omitempty"`
+	Outline *[]struct {
+		Level *int32  `json:"level,omitempty"`
+		Text  *string `json:"text,omitempty"`
+	} `json:"outline,omitempty"`
+}
+
+// Job defines model for Job.
+type Job struct {
+	URLId     *string    `json:"URLId,omitempty"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Error     *string    `json:"error,omitempty"`
+	Id        *string    `json:"id,omitempty"`
+	Status    *JobStatus `json:"status,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	Url       *string    `json:"url,omitempty"`
+	WatchId   *string    `json:"watchId,omitempty"`
+}
+
+// JobStatus defines model for Job.Status.
+type JobStatus string
+
+// Link defines model for Link.
+type Link struct {
+	Error       *string          `json:"error,omitempty"`
+	External    *bool            `json:"external,omitempty"`
+	Href        *string          `json:"href,omitempty"`
+	LatencyMs   *int64           `json:"latencyMs,omitempty"`
+	ResolvedURL *string          `json:"resolvedURL,omitempty"`
+	StatusClass *LinkStatusClass `json:"statusClass,omitempty"`
+	StatusCode  *int32           `json:"statusCode,omitempty"`
+	Text        *string          `json:"text,omitempty"`
+}
+
+// LinkStatusClass defines model for Link.StatusClass.
+type LinkStatusClass string
+
+// Metadata defines model for Metadata.
+type Metadata struct {
+	Canonical         *string `json:"canonical,omitempty"`
+	CanonicalSelf     *bool   `json:"canonicalSelf,omitempty"`
+	Charset           *string `json:"charset,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	DescriptionLength *int32  `json:"descriptionLength,omitempty"`
+	Hreflang          *[]struct {
+		Lang *string `json:"lang,omitempty"`
+		Url  *string `json:"url,omitempty"`
+	} `json:"hreflang,omitempty"`
+	Robots     *[]string           `json:"robots,omitempty"`
+	Viewport   *string             `json:"viewport,omitempty"`
+	Warnings   *[]MetadataWarnings `json:"warnings,omitempty"`
+	XRobotsTag *[]string           `json:"xRobotsTag,omitempty"`
+}
+
+// MetadataWarnings defines model for Metadata.Warnings.
+type MetadataWarnings string
+
+// Problem defines model for Problem.
+type Problem struct {
+	Detail         *string      `json:"detail,omitempty"`
+	Error          *string      `json:"error,omitempty"`
+	Status         *int32       `json:"status,omitempty"`
+	Title          *string      `json:"title,omitempty"`
+	Type           *ProblemType `json:"type,omitempty"`
+	UpstreamStatus *int32       `json:"upstreamStatus,omitempty"`
+}
+
+// ProblemType defines model for Problem.Type.
+type ProblemType string
+
+// Security defines model for Security.
+type Security struct {
+	Cookies *[]struct {
+		HttpOnly *bool   `json:"httpOnly,omitempty"`
+		Name     *string `json:"name,omitempty"`
+		SameSite *string `json:"sameSite,omitempty"`
+		Secure   *bool   `json:"secure,omitempty"`
+	} `json:"cookies,omitempty"`
+	Csp *struct {
+		Directives   *Security_Csp_Directives `json:"directives,omitempty"`
+		Present      *bool                    `json:"present,omitempty"`
+		UnsafeEval   *bool                    `json:"unsafeEval,omitempty"`
+		UnsafeInline *bool                    `json:"unsafeInline,omitempty"`
+	} `json:"csp,omitempty"`
+	Grade *SecurityGrade `json:"grade,omitempty"`
+	Hsts  *struct {
+		IncludeSubDomains *bool  `json:"includeSubDomains,omitempty"`
+		MaxAge            *int64 `json:"maxAge,omitempty"`
+		Preload           *bool  `json:"preload,omitempty"`
+		Present           *bool  `json:"present,omitempty"`
+	} `json:"hsts,omitempty"`
+	PermissionsPolicy *string `json:"permissionsPolicy,omitempty"`
+	ReferrerPolicy    *string `json:"referrerPolicy,omitempty"`
+	Score             *int32  `json:"score,omitempty"`
+	Tls               *struct {
+		CipherSuite   *string    `json:"cipherSuite,omitempty"`
+		ExpiresInDays *int32     `json:"expiresInDays,omitempty"`
+		Issuer        *string    `json:"issuer,omitempty"`
+		NotAfter      *time.Time `json:"notAfter,omitempty"`
+		Subject       *string    `json:"subject,omitempty"`
+		Version       *string    `json:"version,omitempty"`
+	} `json:"tls"`
+	Warnings            *[]SecurityWarnings `json:"warnings,omitempty"`
+	XContentTypeOptions *string             `json:"xContentTypeOptions,omitempty"`
+	XFrameOptions       *string             `json:"xFrameOptions,omitempty"`
+}
+
+// Security_Csp_Directives defines model for Security.Csp.Directives.
+type Security_Csp_Directives struct {
+	AdditionalProperties map[string][]string `json:"-"`
+}
+
+// SecurityGrade defines model for Security.Grade.
+type SecurityGrade string
+
+// SecurityWarnings defines model for Security.Warnings.
+type SecurityWarnings string
+
+// StructuredData defines model for StructuredData.
+type StructuredData struct {
+	Items  *[]StructuredDataItem `json:"items,omitempty"`
+	Jsonld *[]struct {
+		Data  *map[string]interface{} `json:"data,omitempty"`
+		Error *string                 `json:"error,omitempty"`
+	} `json:"jsonld,omitempty"`
+	OpenGraph          *StructuredData_OpenGraph   `json:"openGraph,omitempty"`
+	OpenGraphMissing   *[]string                   `json:"openGraphMissing,omitempty"`
+	TwitterCard        *StructuredData_TwitterCard `json:"twitterCard,omitempty"`
+	TwitterCardMissing *[]string                   `json:"twitterCardMissing,omitempty"`
+}
+
+// StructuredData_OpenGraph defines model for StructuredData.OpenGraph.
+type StructuredData_OpenGraph struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// StructuredData_TwitterCard defines model for StructuredData.TwitterCard.
+type StructuredData_TwitterCard struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// StructuredDataItem defines model for StructuredDataItem.
+type StructuredDataItem struct {
+	Format        *StructuredDataItemFormat `json:"format,omitempty"`
+	MissingFields *[]string                 `json:"missingFields,omitempty"`
+	Types         *[]string                 `json:"types,omitempty"`
+}
+
+// StructuredDataItemFormat defines model for StructuredDataItem.Format.
+type StructuredDataItemFormat string
+
+// Subresource defines model for Subresource.
+type Subresource struct {
+	Error            *string                 `json:"error,omitempty"`
+	Integrity        *string                 `json:"integrity,omitempty"`
+	MissingIntegrity *bool                   `json:"missingIntegrity,omitempty"`
+	MixedContent     *bool                   `json:"mixedContent,omitempty"`
+	StatusClass      *SubresourceStatusClass `json:"statusClass,omitempty"`
+	StatusCode       *int32                  `json:"statusCode,omitempty"`
+	ThirdParty       *bool                   `json:"thirdParty,omitempty"`
+	Type             *SubresourceType        `json:"type,omitempty"`
+	Url              *string                 `json:"url,omitempty"`
+}
+
+// SubresourceStatusClass defines model for Subresource.StatusClass.
+type SubresourceStatusClass string
+
+// SubresourceType defines model for Subresource.Type.
+type SubresourceType string
+
+// Subresources defines model for Subresources.
+type Subresources struct {
+	Checked               *bool          `json:"checked,omitempty"`
+	Items                 *[]Subresource `json:"items,omitempty"`
+	MissingIntegrityCount *int32         `json:"missingIntegrityCount,omitempty"`
+	MixedContentCount     *int32         `json:"mixedContentCount,omitempty"`
+	ThirdPartyCount       *int32         `json:"thirdPartyCount,omitempty"`
+}
+
 // URL defines model for URL.
 type URL struct {
-	HTMLVersion            *string `json:"HTMLVersion,omitempty"`
-	HaveLoginForm          *bool   `json:"HaveLoginForm,omitempty"`
-	HeadingsCount          *string `json:"headingsCount,omitempty"`
-	Id                     *string `json:"id,omitempty"`
-	InaccessibleLinksCount *int32  `json:"inaccessibleLinksCount,omitempty"`
-	LinksCount             *int32  `json:"linksCount,omitempty"`
-	PageTitle              *string `json:"pageTitle,omitempty"`
+	HTMLVersion            *string    `json:"HTMLVersion,omitempty"`
+	HaveLoginForm          *bool      `json:"HaveLoginForm,omitempty"`
+	UGCLinksCount          *int32     `json:"UGCLinksCount,omitempty"`
+	ContentLength          *int64     `json:"contentLength,omitempty"`
+	ContentType            *string    `json:"contentType,omitempty"`
+	CreatedAt              *time.Time `json:"createdAt,omitempty"`
+	Doctype                *Doctype   `json:"doctype,omitempty"`
+	ExternalLinksCount     *int32     `json:"externalLinksCount,omitempty"`
+	FetchDurationMs        *int64     `json:"fetchDurationMs,omitempty"`
+	FinalURL               *string    `json:"finalURL,omitempty"`
+	Forms                  *[]Form    `json:"forms,omitempty"`
+	Headings               *Headings  `json:"headings,omitempty"`
+	Id                     *string    `json:"id,omitempty"`
+	InaccessibleLinksCount *int32     `json:"inaccessibleLinksCount,omitempty"`
+	InternalLinksCount     *int32     `json:"internalLinksCount,omitempty"`
+	LinksCount             *int32     `json:"linksCount,omitempty"`
+	NofollowLinksCount     *int32     `json:"nofollowLinksCount,omitempty"`
+	NormalizedURL          *string    `json:"normalizedURL,omitempty"`
+	PageTitle              *string    `json:"pageTitle,omitempty"`
+	Redirects              *[]struct {
+		StatusCode *int32  `json:"statusCode,omitempty"`
+		Url        *string `json:"url,omitempty"`
+	} `json:"redirects,omitempty"`
+
+	// Findings of the analyzers without a dedicated property, keyed by analyzer name.
+	Sections *struct {
+		Accessibility *struct {
+			Issues *[]AccessibilityIssue `json:"issues,omitempty"`
+		} `json:"accessibility,omitempty"`
+		Metadata       *Metadata       `json:"metadata,omitempty"`
+		Security       *Security       `json:"security,omitempty"`
+		StructuredData *StructuredData `json:"structuredData,omitempty"`
+		Subresources   *Subresources   `json:"subresources,omitempty"`
+	} `json:"sections,omitempty"`
+	SponsoredLinksCount   *int32  `json:"sponsoredLinksCount,omitempty"`
+	StatusCode            *int32  `json:"statusCode,omitempty"`
+	UnsafeBlankLinksCount *int32  `json:"unsafeBlankLinksCount,omitempty"`
+	Url                   *string `json:"url,omitempty"`
+}
+
+// URLDiff defines model for URLDiff.
+type URLDiff struct {
+	Changes        *[]FieldChange `json:"changes,omitempty"`
+	FixedLinks     *[]Link        `json:"fixedLinks,omitempty"`
+	From           *URL           `json:"from,omitempty"`
+	NewBrokenLinks *[]Link        `json:"newBrokenLinks,omitempty"`
+	To             *URL           `json:"to,omitempty"`
+}
+
+// Watch defines model for Watch.
+type Watch struct {
+	CreatedAt  *time.Time       `json:"createdAt,omitempty"`
+	Id         *string          `json:"id,omitempty"`
+	LastError  *string          `json:"lastError,omitempty"`
+	LastJobId  *string          `json:"lastJobId,omitempty"`
+	LastRunAt  *time.Time       `json:"lastRunAt,omitempty"`
+	LastStatus *WatchLastStatus `json:"lastStatus,omitempty"`
+	LastURLId  *string          `json:"lastURLId,omitempty"`
+	NextRunAt  *time.Time       `json:"nextRunAt,omitempty"`
+	Schedule   *string          `json:"schedule,omitempty"`
+	UpdatedAt  *time.Time       `json:"updatedAt,omitempty"`
+	Url        *string          `json:"url,omitempty"`
+}
+
+// WatchLastStatus defines model for Watch.LastStatus.
+type WatchLastStatus string
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt *time.Time       `json:"createdAt,omitempty"`
+	Events    *[]WebhookEvents `json:"events,omitempty"`
+	Id        *string          `json:"id,omitempty"`
+	Secret    *string          `json:"secret,omitempty"`
+	Url       *string          `json:"url,omitempty"`
+}
+
+// WebhookEvents defines model for Webhook.Events.
+type WebhookEvents string
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts       *int32                  `json:"attempts,omitempty"`
+	CreatedAt      *time.Time              `json:"createdAt,omitempty"`
+	Error          *string                 `json:"error,omitempty"`
+	Event          *string                 `json:"event,omitempty"`
+	Id             *string                 `json:"id,omitempty"`
+	NextAttemptAt  *time.Time              `json:"nextAttemptAt,omitempty"`
+	Payload        *map[string]interface{} `json:"payload,omitempty"`
+	ResponseStatus *int32                  `json:"responseStatus,omitempty"`
+	Status         *WebhookDeliveryStatus  `json:"status,omitempty"`
+	UpdatedAt      *time.Time              `json:"updatedAt,omitempty"`
+	WebhookId      *string                 `json:"webhookId,omitempty"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// CrawlResponse defines model for CrawlResponse.
+type CrawlResponse struct {
+	Crawl *Crawl `json:"crawl,omitempty"`
+}
+
+// EnqueueURLsResponse defines model for EnqueueURLsResponse.
+type EnqueueURLsResponse struct {
+	Job *Job `json:"job,omitempty"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Error *string `json:"error,omitempty"`
+type ErrorResponse Problem
+
+// ReadJobsResponse defines model for ReadJobsResponse.
+type ReadJobsResponse struct {
+	Job *Job `json:"job,omitempty"`
 }
 
 // ReadURLsResponse defines model for ReadURLsResponse.
@@ -29,10 +652,292 @@ type SearchURLsResponse struct {
 	URL *URL `json:"URL,omitempty"`
 }
 
+// URLAccessibilityResponse defines model for URLAccessibilityResponse.
+type URLAccessibilityResponse struct {
+	Issues *[]AccessibilityIssue `json:"issues,omitempty"`
+}
+
+// URLDiffResponse defines model for URLDiffResponse.
+type URLDiffResponse struct {
+	Diff *URLDiff `json:"diff,omitempty"`
+}
+
+// URLHistoryResponse defines model for URLHistoryResponse.
+type URLHistoryResponse struct {
+	URLs *[]URL `json:"URLs,omitempty"`
+}
+
+// URLLinksResponse defines model for URLLinksResponse.
+type URLLinksResponse struct {
+	Links *[]Link `json:"links,omitempty"`
+}
+
+// URLsPageResponse defines model for URLsPageResponse.
+type URLsPageResponse struct {
+	URLs       *[]URL  `json:"URLs,omitempty"`
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+// WatchResponse defines model for WatchResponse.
+type WatchResponse struct {
+	Watch *Watch `json:"watch,omitempty"`
+}
+
+// WatchesResponse defines model for WatchesResponse.
+type WatchesResponse struct {
+	Watches *[]Watch `json:"watches,omitempty"`
+}
+
+// WebhookDeliveriesResponse defines model for WebhookDeliveriesResponse.
+type WebhookDeliveriesResponse struct {
+	Deliveries *[]WebhookDelivery `json:"deliveries,omitempty"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	Webhook *Webhook `json:"webhook,omitempty"`
+}
+
+// WebhooksResponse defines model for WebhooksResponse.
+type WebhooksResponse struct {
+	Webhooks *[]Webhook `json:"webhooks,omitempty"`
+}
+
+// CreateCrawlsRequest defines model for CreateCrawlsRequest.
+type CreateCrawlsRequest struct {
+	MaxDepth *int32  `json:"maxDepth,omitempty"`
+	MaxPages *int32  `json:"maxPages,omitempty"`
+	Scope    *string `json:"scope,omitempty"`
+	Url      *string `json:"url,omitempty"`
+}
+
+// CreateWatchesRequest defines model for CreateWatchesRequest.
+type CreateWatchesRequest struct {
+	Schedule *string `json:"schedule,omitempty"`
+	Url      *string `json:"url,omitempty"`
+}
+
+// CreateWebhooksRequest defines model for CreateWebhooksRequest.
+type CreateWebhooksRequest struct {
+	Events *[]string `json:"events,omitempty"`
+	Secret *string   `json:"secret,omitempty"`
+	Url    *string   `json:"url,omitempty"`
+}
+
 // SearchURLsRequest defines model for SearchURLsRequest.
 type SearchURLsRequest struct {
-	URL *string `json:"URL,omitempty"`
+	URL    *string `json:"URL,omitempty"`
+	Async  *bool   `json:"async,omitempty"`
+	MaxAge *int32  `json:"maxAge,omitempty"`
+}
+
+// ListURLsParams defines parameters for ListURLs.
+type ListURLsParams struct {
+	Host          *string             `json:"host,omitempty"`
+	HTMLVersion   *string             `json:"HTMLVersion,omitempty"`
+	HaveLoginForm *bool               `json:"haveLoginForm,omitempty"`
+	CreatedFrom   *time.Time          `json:"createdFrom,omitempty"`
+	CreatedTo     *time.Time          `json:"createdTo,omitempty"`
+	Sort          *ListURLsParamsSort `json:"sort,omitempty"`
+	Cursor        *string             `json:"cursor,omitempty"`
+	Limit         *int32              `json:"limit,omitempty"`
+}
+
+// ListURLsParamsSort defines parameters for ListURLs.
+type ListURLsParamsSort string
+
+// ReadURLHistoryParams defines parameters for ReadURLHistory.
+type ReadURLHistoryParams struct {
+	Url string `json:"url"`
 }
 
+// ReadURLAccessibilityParams defines parameters for ReadURLAccessibility.
+type ReadURLAccessibilityParams struct {
+	Rule     *ReadURLAccessibilityParamsRule     `json:"rule,omitempty"`
+	Severity *ReadURLAccessibilityParamsSeverity `json:"severity,omitempty"`
+}
+
+// ReadURLAccessibilityParamsRule defines parameters for ReadURLAccessibility.
+type ReadURLAccessibilityParamsRule string
+
+// ReadURLAccessibilityParamsSeverity defines parameters for ReadURLAccessibility.
+type ReadURLAccessibilityParamsSeverity string
+
+// ReadURLLinksParams defines parameters for ReadURLLinks.
+type ReadURLLinksParams struct {
+	Status *ReadURLLinksParamsStatus `json:"status,omitempty"`
+}
+
+// ReadURLLinksParamsStatus defines parameters for ReadURLLinks.
+type ReadURLLinksParamsStatus string
+
 // CreateURLJSONRequestBody defines body for CreateURL for application/json ContentType.
 type CreateURLJSONRequestBody SearchURLsRequest
+
+// CreateCrawlJSONRequestBody defines body for CreateCrawl for application/json ContentType.
+type CreateCrawlJSONRequestBody CreateCrawlsRequest
+
+// CreateWatchJSONRequestBody defines body for CreateWatch for application/json ContentType.
+type CreateWatchJSONRequestBody CreateWatchesRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhooksRequest
+
+// Getter for additional properties for Security_Csp_Directives. Returns the specified
+// element and whether it was found
+func (a Security_Csp_Directives) Get(fieldName string) (value []string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Security_Csp_Directives
+func (a *Security_Csp_Directives) Set(fieldName string, value []string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string][]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Security_Csp_Directives to handle AdditionalProperties
+func (a *Security_Csp_Directives) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string][]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal []string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Security_Csp_Directives to handle AdditionalProperties
+func (a Security_Csp_Directives) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StructuredData_OpenGraph. Returns the specified
+// element and whether it was found
+func (a StructuredData_OpenGraph) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StructuredData_OpenGraph
+func (a *StructuredData_OpenGraph) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StructuredData_OpenGraph to handle AdditionalProperties
+func (a *StructuredData_OpenGraph) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StructuredData_OpenGraph to handle AdditionalProperties
+func (a StructuredData_OpenGraph) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StructuredData_TwitterCard. Returns the specified
+// element and whether it was found
+func (a StructuredData_TwitterCard) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StructuredData_TwitterCard
+func (a *StructuredData_TwitterCard) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StructuredData_TwitterCard to handle AdditionalProperties
+func (a *StructuredData_TwitterCard) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("error unmarshaling field %s", fieldName))
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StructuredData_TwitterCard to handle AdditionalProperties
+func (a StructuredData_TwitterCard) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error marshaling '%s'", fieldName))
+		}
+	}
+	return json.Marshal(object)
+}